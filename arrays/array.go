@@ -0,0 +1,267 @@
+package arrays
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/maki5/rutils/arrays/lazy"
+)
+
+// Array generic array type for elements of any type.
+// Methods which compare elements are available on ComparableArray and OrderedArray
+type Array[T any] []T
+
+// Clear remove all elements from array
+func (arr *Array[T]) Clear() {
+	newArr := *arr
+	*arr = newArr[:0]
+}
+
+// Collect return new array contained values returned by the provided function
+func (arr *Array[T]) Collect(exec func(el T) T) []T {
+	newArr := make([]T, 0, len(*arr))
+
+	for _, e := range *arr {
+		newArr = append(newArr, exec(e))
+	}
+
+	return newArr
+}
+
+// Concat append elements of other arrays to self
+func (arr *Array[T]) Concat(arrays ...[]T) {
+	if len(arrays) == 0 {
+		return
+	}
+
+	for _, a := range arrays {
+		*arr = append(*arr, a...)
+	}
+}
+
+// Map return new array contained values returned by the provided function
+func (arr *Array[T]) Map(exec func(el T) T) []T {
+	return arr.Collect(exec)
+}
+
+// Pop removes n (1 by default) last elements from array and returns the last removed one, nil is returned if array has less than n elements
+func (arr *Array[T]) Pop(args ...int) *T {
+	if len(*arr) == 0 {
+		return nil
+	}
+
+	n := 1
+	if len(args) > 0 {
+		n = args[0]
+		if len(*arr) < n || n <= 0 {
+			return nil
+		}
+	}
+
+	last := (*arr)[len(*arr)-n]
+
+	var zero T
+	for i := len(*arr) - n; i < len(*arr); i++ {
+		(*arr)[i] = zero // Erase removed elements (write zero value).
+	}
+	*arr = (*arr)[:len(*arr)-n]
+
+	return &last
+}
+
+// Push append element to array
+func (arr *Array[T]) Push(elem T) {
+	*arr = append(*arr, elem)
+}
+
+// Select returns a new array containing all elements of array for which the given block returns true
+func (arr *Array[T]) Select(exec func(elem T) bool) []T {
+	resArr := make([]T, 0)
+
+	for _, el := range *arr {
+		if exec(el) {
+			resArr = append(resArr, el)
+		}
+	}
+
+	return resArr
+}
+
+// SortFunc sorts array in place using the given comparison function, which returns negative number when a < b,
+// positive number when a > b and zero when they are equal. Sort is not guaranteed to be stable
+//
+// arr := Array[point]{{x: 2}, {x: 1}}
+//
+// arr.SortFunc(func(a, b point) int { return a.x - b.x }) # => [{x: 1}, {x: 2}]
+func (arr *Array[T]) SortFunc(cmp func(a, b T) int) {
+	slices.SortFunc(*arr, cmp)
+}
+
+// SortStableFunc sorts array in place using the given comparison function, equal elements keep their original order
+func (arr *Array[T]) SortStableFunc(cmp func(a, b T) int) {
+	slices.SortStableFunc(*arr, cmp)
+}
+
+// SortedFunc returns sorted copy of array, array itself is not changed
+func (arr *Array[T]) SortedFunc(cmp func(a, b T) int) []T {
+	sorted := slices.Clone([]T(*arr))
+	slices.SortStableFunc(sorted, cmp)
+
+	return sorted
+}
+
+// MinBy returns min element according to the given comparison function, first of equal elements is returned, nil is returned for empty array
+//
+// arr := Array[string]{"pear", "fig", "apple"}
+//
+// arr.MinBy(func(a, b string) int { return len(a) - len(b) }) # => "fig"
+func (arr *Array[T]) MinBy(cmp func(a, b T) int) *T {
+	if len(*arr) == 0 {
+		return nil
+	}
+
+	min := (*arr)[0]
+	for _, el := range (*arr)[1:] {
+		if cmp(el, min) < 0 {
+			min = el
+		}
+	}
+
+	return &min
+}
+
+// MaxBy returns max element according to the given comparison function, first of equal elements is returned, nil is returned for empty array
+func (arr *Array[T]) MaxBy(cmp func(a, b T) int) *T {
+	if len(*arr) == 0 {
+		return nil
+	}
+
+	max := (*arr)[0]
+	for _, el := range (*arr)[1:] {
+		if cmp(el, max) > 0 {
+			max = el
+		}
+	}
+
+	return &max
+}
+
+// Partition returns two arrays, the first contains elements for which the given function returns true and the second contains the rest
+//
+// arr := Array[int]{1, 2, 3, 4}
+//
+// arr.Partition(func(el int) bool { return el%2 == 0 }) # => [2, 4], [1, 3]
+func (arr *Array[T]) Partition(exec func(elem T) bool) ([]T, []T) {
+	matched, rest := make([]T, 0), make([]T, 0)
+
+	for _, el := range *arr {
+		if exec(el) {
+			matched = append(matched, el)
+		} else {
+			rest = append(rest, el)
+		}
+	}
+
+	return matched, rest
+}
+
+// ChunkWhile splits array into runs of consecutive elements, array is split between elements for which the given function returns false
+//
+// arr := Array[int]{1, 2, 4, 9, 10, 11, 12, 15}
+//
+// arr.ChunkWhile(func(a, b int) bool { return b == a+1 }) # => [[1, 2], [4], [9, 10, 11, 12], [15]]
+func (arr *Array[T]) ChunkWhile(exec func(a, b T) bool) [][]T {
+	return arr.SliceWhen(func(a, b T) bool { return !exec(a, b) })
+}
+
+// SliceWhen splits array into runs of consecutive elements, array is split between elements for which the given function returns true
+//
+// arr := Array[int]{1, 2, 4, 9, 10, 11, 12, 15}
+//
+// arr.SliceWhen(func(a, b int) bool { return b != a+1 }) # => [[1, 2], [4], [9, 10, 11, 12], [15]]
+func (arr *Array[T]) SliceWhen(exec func(a, b T) bool) [][]T {
+	chunks := make([][]T, 0)
+	if len(*arr) == 0 {
+		return chunks
+	}
+
+	start := 0
+	for i := 1; i < len(*arr); i++ {
+		if exec((*arr)[i-1], (*arr)[i]) {
+			chunks = append(chunks, slices.Clone((*arr)[start:i]))
+			start = i
+		}
+	}
+
+	return append(chunks, slices.Clone((*arr)[start:]))
+}
+
+// Lazy returns lazy Enumerator over elements of array, chained methods don't allocate intermediate arrays
+//
+// arr := Array[int]{1, 2, 3, 4}
+//
+// arr.Lazy().Filter(isEven).Map(double).ToArray() # => [4, 8]
+func (arr *Array[T]) Lazy() *lazy.Enumerator[T] {
+	return lazy.FromSlice(*arr)
+}
+
+// Convert returns Convertible which converts elements of array using the given mode
+//
+// arr := Array[int]{-1, 300}
+//
+// arr.ToUint32Array()                    # => error, ErrSignLoss at index 0
+//
+// arr.Convert(Saturating).ToUint32Array() # => [0, 300]
+func (arr *Array[T]) Convert(mode ConversionMode) Convertible {
+	return &converter[T]{arr: *arr, mode: mode}
+}
+
+// ToStringArray implements Convertible for converting to string array
+func (arr *Array[T]) ToStringArray() (*[]string, error) {
+	return arr.Convert(Checked).ToStringArray()
+}
+
+// ToFloat64Array implements Convertible for converting to float64 array, ConversionError is returned for values which don't fit into float64
+func (arr *Array[T]) ToFloat64Array() (*[]float64, error) {
+	return arr.Convert(Checked).ToFloat64Array()
+}
+
+// ToFloat32Array implements Convertible for converting to float32 array, ConversionError is returned for values which don't fit into float32
+func (arr *Array[T]) ToFloat32Array() (*[]float32, error) {
+	return arr.Convert(Checked).ToFloat32Array()
+}
+
+// ToInt64Array implements Convertible for converting to int64 array, ConversionError is returned for values which don't fit into int64
+func (arr *Array[T]) ToInt64Array() (*[]int64, error) {
+	return arr.Convert(Checked).ToInt64Array()
+}
+
+// ToInt32Array implements Convertible for converting to int32 array, ConversionError is returned for values which don't fit into int32
+func (arr *Array[T]) ToInt32Array() (*[]int32, error) {
+	return arr.Convert(Checked).ToInt32Array()
+}
+
+// ToUintArray implements Convertible for converting to uint array, ConversionError is returned for values which don't fit into uint
+func (arr *Array[T]) ToUintArray() (*[]uint, error) {
+	return arr.Convert(Checked).ToUintArray()
+}
+
+// ToUint32Array implements Convertible for converting to uint32 array, ConversionError is returned for values which don't fit into uint32
+func (arr *Array[T]) ToUint32Array() (*[]uint32, error) {
+	return arr.Convert(Checked).ToUint32Array()
+}
+
+// ToUint64Array implements Convertible for converting to uint64 array, ConversionError is returned for values which don't fit into uint64
+func (arr *Array[T]) ToUint64Array() (*[]uint64, error) {
+	return arr.Convert(Checked).ToUint64Array()
+}
+
+// ToJSON implements Convertible for converting to json string
+func (arr *Array[T]) ToJSON() (string, error) {
+	data, err := json.Marshal(*arr)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
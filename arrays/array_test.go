@@ -0,0 +1,271 @@
+package arrays
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type point struct {
+	x, y int
+	tags []string
+}
+
+func TestArrayCollect(t *testing.T) {
+	type testData struct {
+		arr      []point
+		execFunc func(el point) point
+		response []point
+	}
+
+	shift := func(el point) point {
+		return point{x: el.x + 1, y: el.y + 1}
+	}
+
+	examples := map[string]testData{
+		"empty arr":         testData{arr: []point{}, execFunc: shift, response: []point{}},
+		"multiple elements": testData{arr: []point{{x: 1}, {y: 2}}, execFunc: shift, response: []point{{x: 2, y: 1}, {x: 1, y: 3}}},
+	}
+
+	for k, v := range examples {
+		initialArr := Array[point](v.arr)
+		resp := initialArr.Collect(v.execFunc)
+
+		if !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed on method Collect with params(initialArr: %v), expected to be %v got %v",
+				k, v.arr, v.response, resp)
+		}
+
+		resp = initialArr.Map(v.execFunc)
+		if !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed on method Map with params(initialArr: %v), expected to be %v got %v",
+				k, v.arr, v.response, resp)
+		}
+	}
+}
+
+func TestArrayConcatAndPush(t *testing.T) {
+	initialArr := Array[point]{{x: 1}}
+	initialArr.Concat([]point{{x: 2}}, []point{{x: 3}})
+	initialArr.Push(point{x: 4})
+
+	response := []point{{x: 1}, {x: 2}, {x: 3}, {x: 4}}
+	if !reflect.DeepEqual([]point(initialArr), response) {
+		t.Errorf("test [concat and push] failed on methods Concat and Push, expected to be %v got %v", response, initialArr)
+	}
+
+	initialArr.Clear()
+	if len(initialArr) != 0 {
+		t.Errorf("test [clear] failed on method Clear, expected length to be 0 got %v", len(initialArr))
+	}
+}
+
+func TestArrayPop(t *testing.T) {
+	type testData struct {
+		arr      []int64
+		newArr   []int64
+		n        int
+		response *int64
+	}
+
+	last := int64(1)
+	second := int64(2)
+
+	examples := map[string]testData{
+		"empty arr":         testData{arr: []int64{}, newArr: []int64{}, n: 1, response: nil},
+		"duplicated values": testData{arr: []int64{1, 2, 1}, newArr: []int64{1, 2}, n: 1, response: &last},
+		"pop two elements":  testData{arr: []int64{1, 2, 1}, newArr: []int64{1}, n: 2, response: &second},
+		"pop too many":      testData{arr: []int64{1, 2}, newArr: []int64{1, 2}, n: 3, response: nil},
+		"pop zero elements": testData{arr: []int64{1, 2}, newArr: []int64{1, 2}, n: 0, response: nil},
+	}
+
+	for k, v := range examples {
+		initialArr := Array[int64](v.arr)
+		resp := initialArr.Pop(v.n)
+
+		if (resp == nil) != (v.response == nil) || (resp != nil && *resp != *v.response) {
+			t.Errorf("test [%v] failed on method Pop with params(initialArr: %v), expected to be %v got %v",
+				k, v.arr, v.response, resp)
+		}
+
+		if !reflect.DeepEqual([]int64(initialArr), v.newArr) {
+			t.Errorf("test [%v] failed on method Pop with params(initialArr: %v), expected to be %v got %v",
+				k, v.arr, v.newArr, initialArr)
+		}
+	}
+}
+
+func TestArraySelect(t *testing.T) {
+	day := 24 * time.Hour
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	initialArr := Array[time.Time]{start, start.Add(day), start.Add(2 * day)}
+	resp := initialArr.Select(func(el time.Time) bool {
+		return el.After(start)
+	})
+
+	response := []time.Time{start.Add(day), start.Add(2 * day)}
+	if !reflect.DeepEqual(resp, response) {
+		t.Errorf("test [select times] failed on method Select, expected to be %v got %v", response, resp)
+	}
+}
+
+func TestArrayConvertible(t *testing.T) {
+	type status int
+
+	statuses := Array[status]{1, 2, 3}
+	ints, err := statuses.ToInt64Array()
+	if err != nil || !reflect.DeepEqual(*ints, []int64{1, 2, 3}) {
+		t.Errorf("test [named type] failed on method ToInt64Array, expected to be %v got %v (error: %v)", []int64{1, 2, 3}, ints, err)
+	}
+
+	strs, err := statuses.ToStringArray()
+	if err != nil || !reflect.DeepEqual(*strs, []string{"1", "2", "3"}) {
+		t.Errorf("test [named type] failed on method ToStringArray, expected to be %v got %v (error: %v)", []string{"1", "2", "3"}, strs, err)
+	}
+
+	floats := Array[float32]{1.5, 2}
+	strs, err = floats.ToStringArray()
+	if err != nil || !reflect.DeepEqual(*strs, []string{"1.5", "2"}) {
+		t.Errorf("test [float32] failed on method ToStringArray, expected to be %v got %v (error: %v)", []string{"1.5", "2"}, strs, err)
+	}
+
+	points := Array[point]{{x: 1}}
+	if _, err := points.ToFloat64Array(); err == nil {
+		t.Errorf("test [unsupported type] failed on method ToFloat64Array, expected error got %v", err)
+	}
+
+	data, err := points.ToJSON()
+	if err != nil || data != "[{}]" {
+		t.Errorf("test [struct] failed on method ToJSON, expected to be %v got %v (error: %v)", "[{}]", data, err)
+	}
+}
+
+func TestArraySortFunc(t *testing.T) {
+	byX := func(a, b point) int { return a.x - b.x }
+
+	initialArr := Array[point]{{x: 2, y: 1}, {x: 1, y: 1}, {x: 2, y: 2}, {x: 1, y: 2}}
+	response := []point{{x: 1, y: 1}, {x: 1, y: 2}, {x: 2, y: 1}, {x: 2, y: 2}}
+
+	sorted := initialArr.SortedFunc(byX)
+	if !reflect.DeepEqual(sorted, response) {
+		t.Errorf("test [stable copy] failed on method SortedFunc, expected to be %v got %v", response, sorted)
+	}
+
+	if initialArr[0].x != 2 || initialArr[0].y != 1 {
+		t.Errorf("test [stable copy] failed on method SortedFunc, expected array to not be changed got %v", initialArr)
+	}
+
+	initialArr.SortStableFunc(byX)
+	if !reflect.DeepEqual([]point(initialArr), response) {
+		t.Errorf("test [stable] failed on method SortStableFunc, expected to be %v got %v", response, initialArr)
+	}
+
+	initialArr.SortFunc(func(a, b point) int { return b.y - a.y })
+	if initialArr[0].y != 2 || initialArr[3].y != 1 {
+		t.Errorf("test [descending] failed on method SortFunc, expected to be sorted by y descending got %v", initialArr)
+	}
+}
+
+func TestArrayMinByMaxBy(t *testing.T) {
+	type testData struct {
+		arr []string
+		min *string
+		max *string
+	}
+
+	byLength := func(a, b string) int { return len(a) - len(b) }
+	fig, pear, apple := "fig", "pear", "apple"
+
+	examples := map[string]testData{
+		"empty arr":         testData{arr: []string{}, min: nil, max: nil},
+		"one element":       testData{arr: []string{"pear"}, min: &pear, max: &pear},
+		"multiple elements": testData{arr: []string{"pear", "fig", "apple", "kiwi", "mango"}, min: &fig, max: &apple},
+	}
+
+	for k, v := range examples {
+		initialArr := Array[string](v.arr)
+
+		resp := initialArr.MinBy(byLength)
+		if (resp == nil) != (v.min == nil) || (resp != nil && *resp != *v.min) {
+			t.Errorf("test [%v] failed on method MinBy with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.min, resp)
+		}
+
+		resp = initialArr.MaxBy(byLength)
+		if (resp == nil) != (v.max == nil) || (resp != nil && *resp != *v.max) {
+			t.Errorf("test [%v] failed on method MaxBy with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.max, resp)
+		}
+	}
+}
+
+func TestArrayPartition(t *testing.T) {
+	type testData struct {
+		arr     []int
+		matched []int
+		rest    []int
+	}
+
+	even := func(el int) bool { return el%2 == 0 }
+
+	examples := map[string]testData{
+		"empty arr":         testData{arr: []int{}, matched: []int{}, rest: []int{}},
+		"nothing matched":   testData{arr: []int{1, 3}, matched: []int{}, rest: []int{1, 3}},
+		"multiple elements": testData{arr: []int{1, 2, 3, 4}, matched: []int{2, 4}, rest: []int{1, 3}},
+	}
+
+	for k, v := range examples {
+		initialArr := Array[int](v.arr)
+		matched, rest := initialArr.Partition(even)
+
+		if !reflect.DeepEqual(matched, v.matched) || !reflect.DeepEqual(rest, v.rest) {
+			t.Errorf("test [%v] failed on method Partition with params(initialArr: %v), expected to be %v, %v got %v, %v",
+				k, v.arr, v.matched, v.rest, matched, rest)
+		}
+	}
+}
+
+func TestArrayChunkWhileAndSliceWhen(t *testing.T) {
+	type testData struct {
+		arr      []int
+		response [][]int
+	}
+
+	examples := map[string]testData{
+		"empty arr":         testData{arr: []int{}, response: [][]int{}},
+		"one element":       testData{arr: []int{1}, response: [][]int{{1}}},
+		"multiple elements": testData{arr: []int{1, 2, 4, 9, 10, 11, 12, 15}, response: [][]int{{1, 2}, {4}, {9, 10, 11, 12}, {15}}},
+		"one run":           testData{arr: []int{3, 4, 5}, response: [][]int{{3, 4, 5}}},
+	}
+
+	for k, v := range examples {
+		initialArr := Array[int](v.arr)
+
+		resp := initialArr.ChunkWhile(func(a, b int) bool { return b == a+1 })
+		if !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed on method ChunkWhile with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.response, resp)
+		}
+
+		resp = initialArr.SliceWhen(func(a, b int) bool { return b != a+1 })
+		if !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed on method SliceWhen with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.response, resp)
+		}
+	}
+
+	initialArr := Array[int]{1, 2, 5}
+	resp := initialArr.SliceWhen(func(a, b int) bool { return b != a+1 })
+	resp[0][0] = 100
+	if initialArr[0] != 1 {
+		t.Errorf("test [copy] failed on method SliceWhen, expected array to not be changed got %v", initialArr)
+	}
+}
+
+func TestArrayLazy(t *testing.T) {
+	initialArr := Array[point]{{x: 1}, {x: 2}, {x: 3}, {x: 4}}
+
+	resp := initialArr.Lazy().Filter(func(el point) bool { return el.x%2 == 0 }).Map(func(el point) point { return point{x: el.x * 10} }).ToArray()
+	response := []point{{x: 20}, {x: 40}}
+
+	if !reflect.DeepEqual(resp, response) {
+		t.Errorf("test [filter and map] failed on method Lazy, expected to be %v got %v", response, resp)
+	}
+}
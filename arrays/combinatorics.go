@@ -0,0 +1,296 @@
+package arrays
+
+import (
+	"errors"
+	"iter"
+	"reflect"
+	"slices"
+)
+
+// ZipPolicy defines how Zip handles arrays of different length
+type ZipPolicy int
+
+const (
+	// ZipShortest stops at the end of the shortest array
+	ZipShortest ZipPolicy = iota
+	// ZipLongest continues to the end of the longest array, missing elements are filled with zero value
+	ZipLongest
+	// ZipStrict returns ErrLengthMismatch if arrays have different length
+	ZipStrict
+)
+
+// ErrLengthMismatch is returned by Zip and Transpose when arrays have different length
+var ErrLengthMismatch = errors.New("arrays have different length")
+
+// Zip merges elements of arrays with the same index into one array
+//
+// Zip(ZipShortest, []int{1, 2, 3}, []int{4, 5}) # => [[1, 4], [2, 5]]
+//
+// Zip(ZipLongest, []int{1, 2, 3}, []int{4, 5})  # => [[1, 4], [2, 5], [3, 0]]
+//
+// Zip(ZipStrict, []int{1, 2, 3}, []int{4, 5})   # => error ErrLengthMismatch
+func Zip[S ~[]T, T any](policy ZipPolicy, arrays ...S) ([][]T, error) {
+	if len(arrays) == 0 {
+		return [][]T{}, nil
+	}
+
+	length := len(arrays[0])
+	for _, arr := range arrays[1:] {
+		switch {
+		case len(arr) == length:
+			continue
+		case policy == ZipStrict:
+			return nil, ErrLengthMismatch
+		case policy == ZipLongest:
+			length = max(length, len(arr))
+		default:
+			length = min(length, len(arr))
+		}
+	}
+
+	zipped := make([][]T, 0, length)
+	for i := 0; i < length; i++ {
+		row := make([]T, len(arrays))
+		for j, arr := range arrays {
+			if i < len(arr) {
+				row[j] = arr[i]
+			}
+		}
+		zipped = append(zipped, row)
+	}
+
+	return zipped, nil
+}
+
+// Transpose swaps rows and columns of 2-D array, ErrLengthMismatch is returned if rows have different length
+//
+// Transpose([][]int{{1, 2, 3}, {4, 5, 6}}) # => [[1, 4], [2, 5], [3, 6]]
+func Transpose[S ~[]T, T any](matrix []S) ([][]T, error) {
+	return Zip(ZipStrict, matrix...)
+}
+
+// Flatten joins nested arrays into one array
+//
+// Flatten([][]int{{1, 2}, {}, {3}}) # => [1, 2, 3]
+func Flatten[S ~[]T, T any](arrays []S) []T {
+	size := 0
+	for _, arr := range arrays {
+		size += len(arr)
+	}
+
+	flat := make([]T, 0, size)
+	for _, arr := range arrays {
+		flat = append(flat, arr...)
+	}
+
+	return flat
+}
+
+// FlattenDeep joins arrays of any nesting into one array, depth limits number of flattened levels, all levels are flattened by default.
+// nil gives empty array, value which is not a slice or array is returned as the only element
+//
+// arr := []interface{}{1, []interface{}{2, []int{3, 4}}}
+//
+// FlattenDeep(arr)    # => [1, 2, 3, 4]
+//
+// FlattenDeep(arr, 1) # => [1, 2, [3, 4]]
+func FlattenDeep(arr interface{}, depth ...int) []interface{} {
+	d := -1
+	if len(depth) > 0 {
+		d = depth[0]
+	}
+
+	v := reflect.ValueOf(arr)
+	switch {
+	case !v.IsValid():
+		return make([]interface{}, 0)
+	case v.Kind() != reflect.Slice && v.Kind() != reflect.Array:
+		return []interface{}{arr}
+	}
+
+	return flattenValue(make([]interface{}, 0), v, d)
+}
+
+func flattenValue(flat []interface{}, v reflect.Value, depth int) []interface{} {
+	for i := 0; i < v.Len(); i++ {
+		el := v.Index(i)
+		for el.Kind() == reflect.Interface && !el.IsNil() {
+			el = el.Elem()
+		}
+
+		if depth != 0 && (el.Kind() == reflect.Slice || el.Kind() == reflect.Array) {
+			flat = flattenValue(flat, el, depth-1)
+			continue
+		}
+
+		flat = append(flat, v.Index(i).Interface())
+	}
+
+	return flat
+}
+
+// Product returns sequence of all combinations of elements of the given arrays, one element is taken from every array.
+// Combinations are generated lazily, so the product of big arrays may be iterated without keeping it in memory
+//
+// for p := range Product([]int{1, 2}, []int{3, 4}) { ... } # => [1, 3], [1, 4], [2, 3], [2, 4]
+func Product[S ~[]T, T any](arrays ...S) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for _, arr := range arrays {
+			if len(arr) == 0 {
+				return
+			}
+		}
+
+		indexes := make([]int, len(arrays))
+		for {
+			p := make([]T, len(arrays))
+			for i, arr := range arrays {
+				p[i] = arr[indexes[i]]
+			}
+
+			if !yield(p) {
+				return
+			}
+
+			// advance indexes like an odometer, the last array changes the fastest
+			i := len(arrays) - 1
+			for ; i >= 0; i-- {
+				indexes[i]++
+				if indexes[i] < len(arrays[i]) {
+					break
+				}
+				indexes[i] = 0
+			}
+
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
+
+// EachSlice splits array into slices of n elements, the last slice may be shorter, nil is returned if n is not positive
+//
+// arr := Array[int]{1, 2, 3, 4, 5}
+//
+// arr.EachSlice(2) # => [[1, 2], [3, 4], [5]]
+func (arr *Array[T]) EachSlice(n int) [][]T {
+	if n <= 0 {
+		return nil
+	}
+
+	batches := make([][]T, 0, (len(*arr)+n-1)/n)
+	for i := 0; i < len(*arr); i += n {
+		batches = append(batches, slices.Clone((*arr)[i:min(i+n, len(*arr))]))
+	}
+
+	return batches
+}
+
+// EachCons returns all windows of n consecutive elements, nil is returned if n is not positive
+//
+// arr := Array[int]{1, 2, 3, 4}
+//
+// arr.EachCons(2) # => [[1, 2], [2, 3], [3, 4]]
+func (arr *Array[T]) EachCons(n int) [][]T {
+	if n <= 0 {
+		return nil
+	}
+
+	windows := make([][]T, 0, max(len(*arr)-n+1, 0))
+	for i := 0; i+n <= len(*arr); i++ {
+		windows = append(windows, slices.Clone((*arr)[i:i+n]))
+	}
+
+	return windows
+}
+
+// Combination returns sequence of all combinations of k elements of array, combinations are generated lazily in lexicographic order of indexes
+//
+// arr := Array[int]{1, 2, 3}
+//
+// for c := range arr.Combination(2) { ... } # => [1, 2], [1, 3], [2, 3]
+func (arr *Array[T]) Combination(k int) iter.Seq[[]T] {
+	elements := slices.Clone(*arr)
+
+	return func(yield func([]T) bool) {
+		n := len(elements)
+		if k < 0 || k > n {
+			return
+		}
+
+		indexes := make([]int, k)
+		for i := range indexes {
+			indexes[i] = i
+		}
+
+		for {
+			c := make([]T, k)
+			for i, index := range indexes {
+				c[i] = elements[index]
+			}
+
+			if !yield(c) {
+				return
+			}
+
+			// find the rightmost index which can be moved forward
+			i := k - 1
+			for i >= 0 && indexes[i] == n-k+i {
+				i--
+			}
+
+			if i < 0 {
+				return
+			}
+
+			indexes[i]++
+			for j := i + 1; j < k; j++ {
+				indexes[j] = indexes[j-1] + 1
+			}
+		}
+	}
+}
+
+// Permutation returns sequence of all permutations of k elements of array, permutations are generated lazily in lexicographic order of indexes
+//
+// arr := Array[int]{1, 2, 3}
+//
+// for p := range arr.Permutation(2) { ... } # => [1, 2], [1, 3], [2, 1], [2, 3], [3, 1], [3, 2]
+func (arr *Array[T]) Permutation(k int) iter.Seq[[]T] {
+	elements := slices.Clone(*arr)
+
+	return func(yield func([]T) bool) {
+		if k < 0 || k > len(elements) {
+			return
+		}
+
+		used := make([]bool, len(elements))
+		p := make([]T, 0, k)
+
+		var permute func() bool
+		permute = func() bool {
+			if len(p) == k {
+				return yield(slices.Clone(p))
+			}
+
+			for i, el := range elements {
+				if used[i] {
+					continue
+				}
+
+				used[i] = true
+				p = append(p, el)
+				if !permute() {
+					return false
+				}
+				p = p[:len(p)-1]
+				used[i] = false
+			}
+
+			return true
+		}
+
+		permute()
+	}
+}
@@ -0,0 +1,192 @@
+package arrays
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	type testData struct {
+		policy   ZipPolicy
+		arrays   [][]int
+		response [][]int
+		err      error
+	}
+
+	examples := map[string]testData{
+		"no arrays":          testData{policy: ZipStrict, arrays: [][]int{}, response: [][]int{}},
+		"equal length":       testData{policy: ZipStrict, arrays: [][]int{{1, 2}, {3, 4}, {5, 6}}, response: [][]int{{1, 3, 5}, {2, 4, 6}}},
+		"shortest":           testData{policy: ZipShortest, arrays: [][]int{{1, 2, 3}, {4, 5}}, response: [][]int{{1, 4}, {2, 5}}},
+		"longest":            testData{policy: ZipLongest, arrays: [][]int{{1, 2}, {4, 5, 6}}, response: [][]int{{1, 4}, {2, 5}, {0, 6}}},
+		"strict mismatch":    testData{policy: ZipStrict, arrays: [][]int{{1, 2, 3}, {4, 5}}, response: nil, err: ErrLengthMismatch},
+		"shortest with none": testData{policy: ZipShortest, arrays: [][]int{{1, 2}, {}}, response: [][]int{}},
+	}
+
+	for k, v := range examples {
+		resp, err := Zip(v.policy, v.arrays...)
+
+		if !reflect.DeepEqual(resp, v.response) || err != v.err {
+			t.Errorf("test [%v] failed on method Zip with params(arrays: %v), expected to be %v got %v and error to be %v got %v",
+				k, v.arrays, v.response, resp, v.err, err)
+		}
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	resp, err := Transpose([][]int{{1, 2, 3}, {4, 5, 6}})
+	response := [][]int{{1, 4}, {2, 5}, {3, 6}}
+
+	if !reflect.DeepEqual(resp, response) || err != nil {
+		t.Errorf("test [matrix] failed on method Transpose, expected to be %v got %v and error %v", response, resp, err)
+	}
+
+	if _, err = Transpose([][]int{{1, 2}, {3}}); err != ErrLengthMismatch {
+		t.Errorf("test [ragged matrix] failed on method Transpose, expected error to be %v got %v", ErrLengthMismatch, err)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	resp := Flatten([]Array[int]{{1, 2}, {}, {3}})
+	if !reflect.DeepEqual(resp, []int{1, 2, 3}) {
+		t.Errorf("test [typed] failed on method Flatten, expected to be %v got %v", []int{1, 2, 3}, resp)
+	}
+
+	type testData struct {
+		arr      interface{}
+		depth    []int
+		response []interface{}
+	}
+
+	nested := []interface{}{1, []interface{}{2, []int{3, 4}}, "five"}
+
+	examples := map[string]testData{
+		"empty arr":   testData{arr: []int{}, response: []interface{}{}},
+		"all levels":  testData{arr: nested, response: []interface{}{1, 2, 3, 4, "five"}},
+		"depth 1":     testData{arr: nested, depth: []int{1}, response: []interface{}{1, 2, []int{3, 4}, "five"}},
+		"depth 0":     testData{arr: nested, depth: []int{0}, response: nested},
+		"typed array": testData{arr: [][]string{{"a"}, {"b", "c"}}, response: []interface{}{"a", "b", "c"}},
+		"go array":    testData{arr: [2][]int{{1}, {2}}, response: []interface{}{1, 2}},
+		"nil":         testData{arr: nil, response: []interface{}{}},
+		"not an arr":  testData{arr: 42, response: []interface{}{42}},
+	}
+
+	for k, v := range examples {
+		resp := FlattenDeep(v.arr, v.depth...)
+
+		if !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed on method FlattenDeep with params(arr: %v, depth: %v), expected to be %v got %v",
+				k, v.arr, v.depth, v.response, resp)
+		}
+	}
+}
+
+func TestProduct(t *testing.T) {
+	type testData struct {
+		arrays   [][]int
+		response [][]int
+	}
+
+	examples := map[string]testData{
+		"no arrays":   testData{arrays: [][]int{}, response: [][]int{{}}},
+		"empty array": testData{arrays: [][]int{{1, 2}, {}}, response: nil},
+		"two arrays":  testData{arrays: [][]int{{1, 2}, {3, 4}}, response: [][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}},
+		"three arrays": testData{arrays: [][]int{{1}, {2, 3}, {4, 5}},
+			response: [][]int{{1, 2, 4}, {1, 2, 5}, {1, 3, 4}, {1, 3, 5}}},
+	}
+
+	for k, v := range examples {
+		resp := slices.Collect(Product(v.arrays...))
+
+		if !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed on method Product with params(arrays: %v), expected to be %v got %v", k, v.arrays, v.response, resp)
+		}
+	}
+
+	count := 0
+	for range Product([]int{1, 2, 3}, []int{4, 5, 6}) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+
+	if count != 2 {
+		t.Errorf("test [break] failed on method Product, expected to stop after 2 elements got %v", count)
+	}
+}
+
+func TestArrayEachSliceAndEachCons(t *testing.T) {
+	type testData struct {
+		arr    []int
+		n      int
+		slices [][]int
+		cons   [][]int
+	}
+
+	examples := map[string]testData{
+		"empty arr":       testData{arr: []int{}, n: 2, slices: [][]int{}, cons: [][]int{}},
+		"not positive":    testData{arr: []int{1, 2}, n: 0, slices: nil, cons: nil},
+		"n is bigger":     testData{arr: []int{1, 2}, n: 3, slices: [][]int{{1, 2}}, cons: [][]int{}},
+		"multiple slices": testData{arr: []int{1, 2, 3, 4, 5}, n: 2, slices: [][]int{{1, 2}, {3, 4}, {5}}, cons: [][]int{{1, 2}, {2, 3}, {3, 4}, {4, 5}}},
+	}
+
+	for k, v := range examples {
+		initialArr := Array[int](v.arr)
+
+		resp := initialArr.EachSlice(v.n)
+		if !reflect.DeepEqual(resp, v.slices) {
+			t.Errorf("test [%v] failed on method EachSlice with params(initialArr: %v, n: %v), expected to be %v got %v", k, v.arr, v.n, v.slices, resp)
+		}
+
+		resp = initialArr.EachCons(v.n)
+		if !reflect.DeepEqual(resp, v.cons) {
+			t.Errorf("test [%v] failed on method EachCons with params(initialArr: %v, n: %v), expected to be %v got %v", k, v.arr, v.n, v.cons, resp)
+		}
+	}
+}
+
+func TestArrayCombinationAndPermutation(t *testing.T) {
+	type testData struct {
+		arr          []int
+		k            int
+		combinations [][]int
+		permutations [][]int
+	}
+
+	examples := map[string]testData{
+		"negative k":   testData{arr: []int{1, 2}, k: -1, combinations: nil, permutations: nil},
+		"k is bigger":  testData{arr: []int{1, 2}, k: 3, combinations: nil, permutations: nil},
+		"zero k":       testData{arr: []int{1, 2}, k: 0, combinations: [][]int{{}}, permutations: [][]int{{}}},
+		"all elements": testData{arr: []int{1, 2}, k: 2, combinations: [][]int{{1, 2}}, permutations: [][]int{{1, 2}, {2, 1}}},
+		"two of three": testData{arr: []int{1, 2, 3}, k: 2, combinations: [][]int{{1, 2}, {1, 3}, {2, 3}},
+			permutations: [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}}},
+	}
+
+	for k, v := range examples {
+		initialArr := Array[int](v.arr)
+
+		resp := slices.Collect(initialArr.Combination(v.k))
+		if !reflect.DeepEqual(resp, v.combinations) {
+			t.Errorf("test [%v] failed on method Combination with params(initialArr: %v, k: %v), expected to be %v got %v", k, v.arr, v.k, v.combinations, resp)
+		}
+
+		resp = slices.Collect(initialArr.Permutation(v.k))
+		if !reflect.DeepEqual(resp, v.permutations) {
+			t.Errorf("test [%v] failed on method Permutation with params(initialArr: %v, k: %v), expected to be %v got %v", k, v.arr, v.k, v.permutations, resp)
+		}
+	}
+
+	count := 0
+	initialArr := Array[int]{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	for range initialArr.Permutation(10) {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+
+	if count != 3 {
+		t.Errorf("test [break] failed on method Permutation, expected to stop after 3 elements got %v", count)
+	}
+}
@@ -0,0 +1,205 @@
+package arrays
+
+import (
+	"iter"
+
+	"github.com/maki5/rutils/arrays/lazy"
+)
+
+// ComparableArray generic array type for elements which can be compared with ==, it has all the methods of Array
+type ComparableArray[T comparable] []T
+
+// Delete deletes first occurrence of the element from array
+func (arr *ComparableArray[T]) Delete(elem T) {
+	// original version got form https://yourbasic.org/golang/delete-element-slice/
+	elemIndex := arr.Index(elem)
+
+	if elemIndex != nil {
+		var zero T
+
+		// Remove the element at index i from a.
+		copy((*arr)[*elemIndex:], (*arr)[*elemIndex+1:]) // Shift a[i+1:] left one index.
+		(*arr)[len(*arr)-1] = zero                       // Erase last element (write zero value).
+		*arr = (*arr)[:len(*arr)-1]                      // Truncate slice.
+	}
+}
+
+// Contains checks if array contains provided element
+func (arr *ComparableArray[T]) Contains(elem T) bool {
+	return arr.Index(elem) != nil
+}
+
+// Index return index of first matched element in array if not found return nil
+func (arr *ComparableArray[T]) Index(elem T) *int {
+	for i, el := range *arr {
+		if el == elem {
+			return &i
+		}
+	}
+
+	return nil
+}
+
+// Uniq removes duplicated elements form given array, first occurrence of every element is kept so the order is preserved
+func (arr *ComparableArray[T]) Uniq() {
+	UniqBy(arr, func(el T) T { return el })
+}
+
+// Tally returns number of occurrences of every element
+//
+// arr := ComparableArray[string]{"a", "b", "a"}
+//
+// arr.Tally() # => {"a": 2, "b": 1}
+func (arr *ComparableArray[T]) Tally() map[T]int {
+	return CountBy(*arr, func(el T) T { return el })
+}
+
+// Clear remove all elements from array
+func (arr *ComparableArray[T]) Clear() {
+	(*Array[T])(arr).Clear()
+}
+
+// Collect return new array contained values returned by the provided function
+func (arr *ComparableArray[T]) Collect(exec func(el T) T) []T {
+	return (*Array[T])(arr).Collect(exec)
+}
+
+// Concat append elements of other arrays to self
+func (arr *ComparableArray[T]) Concat(arrays ...[]T) {
+	(*Array[T])(arr).Concat(arrays...)
+}
+
+// Map return new array contained values returned by the provided function
+func (arr *ComparableArray[T]) Map(exec func(el T) T) []T {
+	return (*Array[T])(arr).Map(exec)
+}
+
+// Pop removes n (1 by default) last elements from array and returns the last removed one, nil is returned if array has less than n elements
+func (arr *ComparableArray[T]) Pop(args ...int) *T {
+	return (*Array[T])(arr).Pop(args...)
+}
+
+// Push append element to array
+func (arr *ComparableArray[T]) Push(elem T) {
+	(*Array[T])(arr).Push(elem)
+}
+
+// Select returns a new array containing all elements of array for which the given block returns true
+func (arr *ComparableArray[T]) Select(exec func(elem T) bool) []T {
+	return (*Array[T])(arr).Select(exec)
+}
+
+// SortFunc sorts array in place using the given comparison function
+func (arr *ComparableArray[T]) SortFunc(cmp func(a, b T) int) {
+	(*Array[T])(arr).SortFunc(cmp)
+}
+
+// SortStableFunc sorts array in place using the given comparison function, equal elements keep their original order
+func (arr *ComparableArray[T]) SortStableFunc(cmp func(a, b T) int) {
+	(*Array[T])(arr).SortStableFunc(cmp)
+}
+
+// SortedFunc returns sorted copy of array, array itself is not changed
+func (arr *ComparableArray[T]) SortedFunc(cmp func(a, b T) int) []T {
+	return (*Array[T])(arr).SortedFunc(cmp)
+}
+
+// MinBy returns min element according to the given comparison function, nil is returned for empty array
+func (arr *ComparableArray[T]) MinBy(cmp func(a, b T) int) *T {
+	return (*Array[T])(arr).MinBy(cmp)
+}
+
+// MaxBy returns max element according to the given comparison function, nil is returned for empty array
+func (arr *ComparableArray[T]) MaxBy(cmp func(a, b T) int) *T {
+	return (*Array[T])(arr).MaxBy(cmp)
+}
+
+// Partition returns two arrays, the first contains elements for which the given function returns true and the second contains the rest
+func (arr *ComparableArray[T]) Partition(exec func(elem T) bool) ([]T, []T) {
+	return (*Array[T])(arr).Partition(exec)
+}
+
+// ChunkWhile splits array into runs of consecutive elements, array is split between elements for which the given function returns false
+func (arr *ComparableArray[T]) ChunkWhile(exec func(a, b T) bool) [][]T {
+	return (*Array[T])(arr).ChunkWhile(exec)
+}
+
+// SliceWhen splits array into runs of consecutive elements, array is split between elements for which the given function returns true
+func (arr *ComparableArray[T]) SliceWhen(exec func(a, b T) bool) [][]T {
+	return (*Array[T])(arr).SliceWhen(exec)
+}
+
+// EachSlice splits array into slices of n elements, the last slice may be shorter, nil is returned if n is not positive
+func (arr *ComparableArray[T]) EachSlice(n int) [][]T {
+	return (*Array[T])(arr).EachSlice(n)
+}
+
+// EachCons returns all windows of n consecutive elements, nil is returned if n is not positive
+func (arr *ComparableArray[T]) EachCons(n int) [][]T {
+	return (*Array[T])(arr).EachCons(n)
+}
+
+// Combination returns sequence of all combinations of k elements of array, combinations are generated lazily
+func (arr *ComparableArray[T]) Combination(k int) iter.Seq[[]T] {
+	return (*Array[T])(arr).Combination(k)
+}
+
+// Permutation returns sequence of all permutations of k elements of array, permutations are generated lazily
+func (arr *ComparableArray[T]) Permutation(k int) iter.Seq[[]T] {
+	return (*Array[T])(arr).Permutation(k)
+}
+
+// Lazy returns lazy Enumerator over elements of array, chained methods don't allocate intermediate arrays
+func (arr *ComparableArray[T]) Lazy() *lazy.Enumerator[T] {
+	return (*Array[T])(arr).Lazy()
+}
+
+// Convert returns Convertible which converts elements of array using the given mode
+func (arr *ComparableArray[T]) Convert(mode ConversionMode) Convertible {
+	return (*Array[T])(arr).Convert(mode)
+}
+
+// ToStringArray implements Convertible for converting to string array
+func (arr *ComparableArray[T]) ToStringArray() (*[]string, error) {
+	return (*Array[T])(arr).ToStringArray()
+}
+
+// ToFloat64Array implements Convertible for converting to float64 array
+func (arr *ComparableArray[T]) ToFloat64Array() (*[]float64, error) {
+	return (*Array[T])(arr).ToFloat64Array()
+}
+
+// ToFloat32Array implements Convertible for converting to float32 array
+func (arr *ComparableArray[T]) ToFloat32Array() (*[]float32, error) {
+	return (*Array[T])(arr).ToFloat32Array()
+}
+
+// ToInt64Array implements Convertible for converting to int64 array
+func (arr *ComparableArray[T]) ToInt64Array() (*[]int64, error) {
+	return (*Array[T])(arr).ToInt64Array()
+}
+
+// ToInt32Array implements Convertible for converting to int32 array
+func (arr *ComparableArray[T]) ToInt32Array() (*[]int32, error) {
+	return (*Array[T])(arr).ToInt32Array()
+}
+
+// ToUintArray implements Convertible for converting to uint array
+func (arr *ComparableArray[T]) ToUintArray() (*[]uint, error) {
+	return (*Array[T])(arr).ToUintArray()
+}
+
+// ToUint32Array implements Convertible for converting to uint32 array
+func (arr *ComparableArray[T]) ToUint32Array() (*[]uint32, error) {
+	return (*Array[T])(arr).ToUint32Array()
+}
+
+// ToUint64Array implements Convertible for converting to uint64 array
+func (arr *ComparableArray[T]) ToUint64Array() (*[]uint64, error) {
+	return (*Array[T])(arr).ToUint64Array()
+}
+
+// ToJSON implements Convertible for converting to json string
+func (arr *ComparableArray[T]) ToJSON() (string, error) {
+	return (*Array[T])(arr).ToJSON()
+}
@@ -0,0 +1,119 @@
+package arrays
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type userID struct {
+	tenant string
+	id     int
+}
+
+func TestComparableArrayDelete(t *testing.T) {
+	type testData struct {
+		arr      []userID
+		selector userID
+		response []userID
+	}
+
+	examples := map[string]testData{
+		"empty arr":            testData{arr: []userID{}, selector: userID{"a", 1}, response: []userID{}},
+		"first occurrence":     testData{arr: []userID{{"a", 1}, {"b", 2}, {"a", 1}}, selector: userID{"a", 1}, response: []userID{{"b", 2}, {"a", 1}}},
+		"missing element":      testData{arr: []userID{{"a", 1}}, selector: userID{"a", 2}, response: []userID{{"a", 1}}},
+		"last element":         testData{arr: []userID{{"a", 1}, {"b", 2}}, selector: userID{"b", 2}, response: []userID{{"a", 1}}},
+		"the only one element": testData{arr: []userID{{"a", 1}}, selector: userID{"a", 1}, response: []userID{}},
+	}
+
+	for k, v := range examples {
+		initialArr := ComparableArray[userID](v.arr)
+		initialArr.Delete(v.selector)
+
+		if !reflect.DeepEqual([]userID(initialArr), v.response) {
+			t.Errorf("test [%v] failed on method Delete with params(initialArr: %v, selector %v), expected %v got %v",
+				k, v.arr, v.selector, v.response, initialArr)
+		}
+	}
+}
+
+func TestComparableArrayContainsAndIndex(t *testing.T) {
+	type testData struct {
+		arr      []time.Time
+		selector time.Time
+		response *int
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	first := 0
+	second := 1
+
+	examples := map[string]testData{
+		"empty arr":       testData{arr: []time.Time{}, selector: start, response: nil},
+		"first element":   testData{arr: []time.Time{start, start}, selector: start, response: &first},
+		"second element":  testData{arr: []time.Time{start, start.Add(time.Hour)}, selector: start.Add(time.Hour), response: &second},
+		"missing element": testData{arr: []time.Time{start}, selector: start.Add(time.Hour), response: nil},
+	}
+
+	for k, v := range examples {
+		initialArr := ComparableArray[time.Time](v.arr)
+		resp := initialArr.Index(v.selector)
+
+		if (resp == nil) != (v.response == nil) || (resp != nil && *resp != *v.response) {
+			t.Errorf("test [%v] failed on method Index with params(initialArr: %v, selector: %v), expected to be %v got %v",
+				k, v.arr, v.selector, v.response, resp)
+		}
+
+		if initialArr.Contains(v.selector) != (v.response != nil) {
+			t.Errorf("test [%v] failed on method Contains with params(initialArr: %v, selector: %v), expected to be %v",
+				k, v.arr, v.selector, v.response != nil)
+		}
+	}
+}
+
+func TestComparableArrayUniq(t *testing.T) {
+	type testData struct {
+		arr      []userID
+		response []userID
+	}
+
+	examples := map[string]testData{
+		"empty arr":          testData{arr: []userID{}, response: []userID{}},
+		"duplicates":         testData{arr: []userID{{"a", 1}, {"a", 1}, {"a", 1}}, response: []userID{{"a", 1}}},
+		"first occurrence":   testData{arr: []userID{{"c", 3}, {"a", 1}, {"c", 3}, {"b", 2}, {"a", 1}}, response: []userID{{"c", 3}, {"a", 1}, {"b", 2}}},
+		"without duplicates": testData{arr: []userID{{"b", 2}, {"a", 1}}, response: []userID{{"b", 2}, {"a", 1}}},
+	}
+
+	for k, v := range examples {
+		initialArr := ComparableArray[userID](v.arr)
+		initialArr.Uniq()
+
+		if !reflect.DeepEqual([]userID(initialArr), v.response) {
+			t.Errorf("test [%v] failed on method Uniq with params(initialArr: %v), expected to be %v got %v",
+				k, v.arr, v.response, initialArr)
+		}
+	}
+}
+
+func TestComparableArrayTally(t *testing.T) {
+	type testData struct {
+		arr      []userID
+		response map[userID]int
+	}
+
+	bob, alice := userID{tenant: "a", id: 1}, userID{tenant: "b", id: 1}
+
+	examples := map[string]testData{
+		"empty arr":         testData{arr: []userID{}, response: map[userID]int{}},
+		"multiple elements": testData{arr: []userID{bob, alice, bob}, response: map[userID]int{bob: 2, alice: 1}},
+	}
+
+	for k, v := range examples {
+		initialArr := ComparableArray[userID](v.arr)
+		resp := initialArr.Tally()
+
+		if !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed on method Tally with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.response, resp)
+		}
+	}
+}
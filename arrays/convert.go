@@ -0,0 +1,397 @@
+package arrays
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// ConversionMode defines how Convertible methods handle values which don't fit into the target type
+type ConversionMode int
+
+const (
+	// Checked returns ConversionError for values which don't fit into the target type or lose precision, it is used by default
+	Checked ConversionMode = iota
+	// Saturating clamps values to the range of the target type and drops fractional part of floats, NaN is converted to zero
+	Saturating
+	// Truncating converts values the same way go conversion does: integers wrap around and fractional part of floats is dropped
+	Truncating
+)
+
+var (
+	// ErrOverflow value is out of range of the target type
+	ErrOverflow = errors.New("value is out of range")
+	// ErrSignLoss negative value is converted to unsigned type
+	ErrSignLoss = errors.New("negative value can't be unsigned")
+	// ErrNotFinite NaN or infinity is converted to integer type
+	ErrNotFinite = errors.New("value is not finite")
+	// ErrPrecisionLoss value can't be represented exactly by the target type
+	ErrPrecisionLoss = errors.New("value loses precision")
+	// ErrUnsupportedType element type can't be converted to a number
+	ErrUnsupportedType = errors.New("type is not supported")
+)
+
+// ConversionError is returned by Convertible methods when element of array can't be converted to the target type,
+// Err is one of ErrOverflow, ErrSignLoss, ErrNotFinite, ErrPrecisionLoss, ErrUnsupportedType or strconv error for strings
+type ConversionError struct {
+	Index  int
+	Value  interface{}
+	Target string
+	Err    error
+}
+
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("can't convert element %v (%v) to %v: %v", e.Index, e.Value, e.Target, e.Err)
+}
+
+// Unwrap returns the reason of the error
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// converter implements Convertible for any array with the given conversion mode
+type converter[T any] struct {
+	arr  []T
+	mode ConversionMode
+}
+
+func (c *converter[T]) ToStringArray() (*[]string, error) {
+	return convertArray(c.arr, "string", func(el T) (string, error) {
+		return toString(el), nil
+	})
+}
+
+func (c *converter[T]) ToFloat64Array() (*[]float64, error) {
+	return convertArray(c.arr, "float64", func(el T) (float64, error) {
+		return toFloat(el, 64, c.mode)
+	})
+}
+
+func (c *converter[T]) ToFloat32Array() (*[]float32, error) {
+	return convertArray(c.arr, "float32", func(el T) (float32, error) {
+		f, err := toFloat(el, 32, c.mode)
+		return float32(f), err
+	})
+}
+
+func (c *converter[T]) ToInt64Array() (*[]int64, error) {
+	return convertArray(c.arr, "int64", func(el T) (int64, error) {
+		return toSigned(el, 64, c.mode)
+	})
+}
+
+func (c *converter[T]) ToInt32Array() (*[]int32, error) {
+	return convertArray(c.arr, "int32", func(el T) (int32, error) {
+		i, err := toSigned(el, 32, c.mode)
+		return int32(i), err
+	})
+}
+
+func (c *converter[T]) ToUintArray() (*[]uint, error) {
+	return convertArray(c.arr, "uint", func(el T) (uint, error) {
+		i, err := toUnsigned(el, strconv.IntSize, c.mode)
+		return uint(i), err
+	})
+}
+
+func (c *converter[T]) ToUint32Array() (*[]uint32, error) {
+	return convertArray(c.arr, "uint32", func(el T) (uint32, error) {
+		i, err := toUnsigned(el, 32, c.mode)
+		return uint32(i), err
+	})
+}
+
+func (c *converter[T]) ToUint64Array() (*[]uint64, error) {
+	return convertArray(c.arr, "uint64", func(el T) (uint64, error) {
+		return toUnsigned(el, 64, c.mode)
+	})
+}
+
+func (c *converter[T]) ToJSON() (string, error) {
+	return (*Array[T])(&c.arr).ToJSON()
+}
+
+// convertArray converts every element of array with the given function, first error stops conversion
+func convertArray[T any, R any](arr []T, target string, convert func(el T) (R, error)) (*[]R, error) {
+	newArr := make([]R, 0, len(arr))
+
+	for i, el := range arr {
+		r, err := convert(el)
+		if err != nil {
+			return nil, &ConversionError{Index: i, Value: el, Target: target, Err: err}
+		}
+		newArr = append(newArr, r)
+	}
+
+	return &newArr, nil
+}
+
+type numberKind int
+
+const (
+	signedNumber numberKind = iota
+	unsignedNumber
+	floatNumber
+)
+
+// number keeps a value of any numeric type without losing precision
+type number struct {
+	kind numberKind
+	i    int64
+	u    uint64
+	f    float64
+}
+
+// toNumber converts numbers of any type (including named types) and numeric strings to number
+func toNumber(el interface{}) (number, error) {
+	v := reflect.ValueOf(el)
+	switch v.Kind() {
+	case reflect.String:
+		return parseNumber(v.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{kind: signedNumber, i: v.Int()}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return number{kind: unsignedNumber, u: v.Uint()}, nil
+	case reflect.Float32, reflect.Float64:
+		return number{kind: floatNumber, f: v.Float()}, nil
+	}
+
+	return number{}, ErrUnsupportedType
+}
+
+func parseNumber(str string) (number, error) {
+	if i, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return number{kind: signedNumber, i: i}, nil
+	}
+
+	if u, err := strconv.ParseUint(str, 10, 64); err == nil {
+		return number{kind: unsignedNumber, u: u}, nil
+	}
+
+	f, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return number{}, err
+	}
+
+	return number{kind: floatNumber, f: f}, nil
+}
+
+// toSigned converts element to signed integer of the given size, in Truncating mode result should be wrapped by the caller with go conversion
+func toSigned(el interface{}, bits int, mode ConversionMode) (int64, error) {
+	n, err := toNumber(el)
+	if err != nil {
+		return 0, err
+	}
+
+	min := int64(-1) << (bits - 1)
+	max := -(min + 1)
+
+	switch n.kind {
+	case signedNumber:
+		if mode == Truncating {
+			return n.i, nil
+		}
+
+		if n.i < min || n.i > max {
+			if mode == Saturating {
+				return clampSigned(n.i < min, min, max), nil
+			}
+			return 0, ErrOverflow
+		}
+
+		return n.i, nil
+	case unsignedNumber:
+		if mode == Truncating {
+			return int64(n.u), nil
+		}
+
+		if n.u > uint64(max) {
+			if mode == Saturating {
+				return max, nil
+			}
+			return 0, ErrOverflow
+		}
+
+		return int64(n.u), nil
+	}
+
+	if mode == Truncating {
+		return int64(n.f), nil
+	}
+
+	switch {
+	case math.IsNaN(n.f):
+		if mode == Saturating {
+			return 0, nil
+		}
+		return 0, ErrNotFinite
+	case n.f < float64(min) || n.f >= float64(max)+1:
+		if mode == Saturating {
+			return clampSigned(n.f < 0, min, max), nil
+		}
+
+		if math.IsInf(n.f, 0) {
+			return 0, ErrNotFinite
+		}
+		return 0, ErrOverflow
+	case mode == Checked && n.f != math.Trunc(n.f):
+		return 0, ErrPrecisionLoss
+	}
+
+	return int64(n.f), nil
+}
+
+// toUnsigned converts element to unsigned integer of the given size, in Truncating mode result should be wrapped by the caller with go conversion
+func toUnsigned(el interface{}, bits int, mode ConversionMode) (uint64, error) {
+	n, err := toNumber(el)
+	if err != nil {
+		return 0, err
+	}
+
+	max := uint64(math.MaxUint64) >> (64 - bits)
+
+	switch n.kind {
+	case signedNumber:
+		if mode == Truncating {
+			return uint64(n.i), nil
+		}
+
+		if n.i < 0 {
+			if mode == Saturating {
+				return 0, nil
+			}
+			return 0, ErrSignLoss
+		}
+
+		if uint64(n.i) > max {
+			if mode == Saturating {
+				return max, nil
+			}
+			return 0, ErrOverflow
+		}
+
+		return uint64(n.i), nil
+	case unsignedNumber:
+		if mode == Truncating {
+			return n.u, nil
+		}
+
+		if n.u > max {
+			if mode == Saturating {
+				return max, nil
+			}
+			return 0, ErrOverflow
+		}
+
+		return n.u, nil
+	}
+
+	if mode == Truncating {
+		return uint64(n.f), nil
+	}
+
+	switch {
+	case math.IsNaN(n.f):
+		if mode == Saturating {
+			return 0, nil
+		}
+		return 0, ErrNotFinite
+	case n.f < 0:
+		if mode == Saturating {
+			return 0, nil
+		}
+
+		if math.IsInf(n.f, 0) {
+			return 0, ErrNotFinite
+		}
+		return 0, ErrSignLoss
+	case n.f >= float64(max)+1:
+		if mode == Saturating {
+			return max, nil
+		}
+
+		if math.IsInf(n.f, 0) {
+			return 0, ErrNotFinite
+		}
+		return 0, ErrOverflow
+	case mode == Checked && n.f != math.Trunc(n.f):
+		return 0, ErrPrecisionLoss
+	}
+
+	return uint64(n.f), nil
+}
+
+// toFloat converts element to float of the given size, integers which can't be represented exactly are reported in Checked mode
+func toFloat(el interface{}, bits int, mode ConversionMode) (float64, error) {
+	n, err := toNumber(el)
+	if err != nil {
+		return 0, err
+	}
+
+	switch n.kind {
+	case signedNumber:
+		f := roundFloat(float64(n.i), bits)
+		if mode == Checked && (f >= math.MaxInt64 || int64(f) != n.i) {
+			return 0, ErrPrecisionLoss
+		}
+		return f, nil
+	case unsignedNumber:
+		f := roundFloat(float64(n.u), bits)
+		if mode == Checked && (f >= math.MaxUint64 || uint64(f) != n.u) {
+			return 0, ErrPrecisionLoss
+		}
+		return f, nil
+	}
+
+	if bits == 32 && !math.IsInf(n.f, 0) && math.Abs(n.f) > math.MaxFloat32 {
+		switch mode {
+		case Checked:
+			return 0, ErrOverflow
+		case Saturating:
+			return math.Copysign(math.MaxFloat32, n.f), nil
+		}
+	}
+
+	return n.f, nil
+}
+
+func roundFloat(f float64, bits int) float64 {
+	if bits == 32 {
+		return float64(float32(f))
+	}
+
+	return f
+}
+
+func clampSigned(negative bool, min, max int64) int64 {
+	if negative {
+		return min
+	}
+
+	return max
+}
+
+// toString formats numbers and strings (including named types), fmt.Stringer and other values are formatted with fmt
+func toString[T any](el T) string {
+	if s, ok := any(el).(fmt.Stringer); ok {
+		return s.String()
+	}
+
+	v := reflect.ValueOf(el)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	}
+
+	return fmt.Sprint(el)
+}
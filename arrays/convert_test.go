@@ -0,0 +1,145 @@
+package arrays
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestConvertChecked(t *testing.T) {
+	type testData struct {
+		convert func() (interface{}, error)
+		index   int
+		err     error
+	}
+
+	examples := map[string]testData{
+		"int overflows int32": testData{convert: func() (interface{}, error) {
+			arr := Array[int]{1, math.MaxInt32 + 1}
+			return arr.ToInt32Array()
+		}, index: 1, err: ErrOverflow},
+		"negative int to uint": testData{convert: func() (interface{}, error) {
+			arr := Array[int]{-1, 300}
+			return arr.ToUint32Array()
+		}, index: 0, err: ErrSignLoss},
+		"uint64 overflows int64": testData{convert: func() (interface{}, error) {
+			arr := Array[uint64]{math.MaxUint64}
+			return arr.ToInt64Array()
+		}, index: 0, err: ErrOverflow},
+		"NaN to int": testData{convert: func() (interface{}, error) {
+			arr := Array[float64]{1, math.NaN()}
+			return arr.ToInt64Array()
+		}, index: 1, err: ErrNotFinite},
+		"infinity to uint": testData{convert: func() (interface{}, error) {
+			arr := Array[float64]{math.Inf(1)}
+			return arr.ToUint64Array()
+		}, index: 0, err: ErrNotFinite},
+		"fraction to int": testData{convert: func() (interface{}, error) {
+			arr := Array[float64]{1, 2, 1.5}
+			return arr.ToInt32Array()
+		}, index: 2, err: ErrPrecisionLoss},
+		"huge int to float64": testData{convert: func() (interface{}, error) {
+			arr := Array[int64]{1<<53 + 1}
+			return arr.ToFloat64Array()
+		}, index: 0, err: ErrPrecisionLoss},
+		"int to float32": testData{convert: func() (interface{}, error) {
+			arr := Array[int32]{1<<24 + 1}
+			return arr.ToFloat32Array()
+		}, index: 0, err: ErrPrecisionLoss},
+		"float64 overflows float32": testData{convert: func() (interface{}, error) {
+			arr := Array[float64]{math.MaxFloat64}
+			return arr.ToFloat32Array()
+		}, index: 0, err: ErrOverflow},
+		"not a number string": testData{convert: func() (interface{}, error) {
+			arr := Array[string]{"1", "abc"}
+			return arr.ToInt64Array()
+		}, index: 1, err: strconv.ErrSyntax},
+		"unsupported type": testData{convert: func() (interface{}, error) {
+			arr := Array[point]{{x: 1}}
+			return arr.ToFloat64Array()
+		}, index: 0, err: ErrUnsupportedType},
+	}
+
+	for k, v := range examples {
+		_, err := v.convert()
+
+		if !errors.Is(err, v.err) {
+			t.Errorf("test [%v] failed, expected error to be %v got %v", k, v.err, err)
+			continue
+		}
+
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) || convErr.Index != v.index {
+			t.Errorf("test [%v] failed, expected ConversionError with index %v got %v", k, v.index, err)
+		}
+	}
+}
+
+func TestConvertCheckedValid(t *testing.T) {
+	arr := Array[string]{"-1", "2", "18446744073709551615"}
+	if _, err := arr.ToInt64Array(); err == nil {
+		t.Errorf("test [max uint64 string to int64] failed, expected error got nil")
+	}
+
+	ints := Array[int64]{-5, 0, math.MaxInt32}
+	resp, err := ints.ToInt32Array()
+	if err != nil || !reflect.DeepEqual(*resp, []int32{-5, 0, math.MaxInt32}) {
+		t.Errorf("test [int64 to int32] failed, expected to be %v got %v, %v", ints, resp, err)
+	}
+
+	floats := Array[float64]{2, -3, 1 << 53}
+	intResp, err := floats.ToInt64Array()
+	if err != nil || !reflect.DeepEqual(*intResp, []int64{2, -3, 1 << 53}) {
+		t.Errorf("test [whole floats to int64] failed, expected to be %v got %v, %v", floats, intResp, err)
+	}
+
+	uints := Array[string]{"18446744073709551615"}
+	uintResp, err := uints.ToUint64Array()
+	if err != nil || !reflect.DeepEqual(*uintResp, []uint64{math.MaxUint64}) {
+		t.Errorf("test [string to uint64] failed, expected to be %v got %v, %v", uints, uintResp, err)
+	}
+}
+
+func TestConvertModes(t *testing.T) {
+	type testData struct {
+		arr      Convertible
+		convert  func(c Convertible) (interface{}, error)
+		response interface{}
+	}
+
+	toInt32 := func(c Convertible) (interface{}, error) {
+		resp, err := c.ToInt32Array()
+		if err != nil {
+			return nil, err
+		}
+		return *resp, nil
+	}
+	toUint32 := func(c Convertible) (interface{}, error) {
+		resp, err := c.ToUint32Array()
+		if err != nil {
+			return nil, err
+		}
+		return *resp, nil
+	}
+
+	ints := Array[int64]{-1, math.MaxInt32 + 1, math.MinInt32 - 1}
+	floats := Array[float64]{-1.5, 1.9, math.NaN(), math.Inf(1)}
+
+	examples := map[string]testData{
+		"saturating ints to int32":    testData{arr: ints.Convert(Saturating), convert: toInt32, response: []int32{-1, math.MaxInt32, math.MinInt32}},
+		"saturating ints to uint32":   testData{arr: ints.Convert(Saturating), convert: toUint32, response: []uint32{0, math.MaxInt32 + 1, 0}},
+		"truncating ints to int32":    testData{arr: ints.Convert(Truncating), convert: toInt32, response: []int32{-1, math.MinInt32, math.MaxInt32}},
+		"truncating ints to uint32":   testData{arr: ints.Convert(Truncating), convert: toUint32, response: []uint32{math.MaxUint32, math.MaxInt32 + 1, math.MaxInt32}},
+		"saturating floats to int32":  testData{arr: floats.Convert(Saturating), convert: toInt32, response: []int32{-1, 1, 0, math.MaxInt32}},
+		"saturating floats to uint32": testData{arr: floats.Convert(Saturating), convert: toUint32, response: []uint32{0, 1, 0, math.MaxUint32}},
+	}
+
+	for k, v := range examples {
+		resp, err := v.convert(v.arr)
+		if err != nil || !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed, expected to be %v got %v, %v", k, v.response, resp, err)
+		}
+	}
+}
@@ -1,8 +1,9 @@
-package arrays
+package arrays_test
 
 import (
 	"testing"
 
+	"github.com/maki5/rutils/arrays"
 	arrayOfFloats "github.com/maki5/rutils/arrays/of_float64"
 	arrayOfInts "github.com/maki5/rutils/arrays/of_int"
 	arrayOfStrings "github.com/maki5/rutils/arrays/of_string"
@@ -12,12 +13,18 @@ func TestConvertible(t *testing.T) {
 	sa := arrayOfStrings.StringArray{}
 	ia := arrayOfInts.IntArray{}
 	fa := arrayOfFloats.FloatArray{}
+	ga := arrays.Array[int64]{}
+	ca := arrays.ComparableArray[uint32]{}
+	oa := arrays.OrderedArray[float32]{}
 
 	acceptAndCheckConvertible(&sa)
 	acceptAndCheckConvertible(&ia)
 	acceptAndCheckConvertible(&fa)
+	acceptAndCheckConvertible(&ga)
+	acceptAndCheckConvertible(&ca)
+	acceptAndCheckConvertible(&oa)
 }
 
-func acceptAndCheckConvertible(c Convertible) bool {
+func acceptAndCheckConvertible(c arrays.Convertible) bool {
 	return true
 }
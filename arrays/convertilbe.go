@@ -1,6 +1,8 @@
 package arrays
 
-// Convertible interface is used for converting arrays of different types
+// Convertible interface is used for converting arrays of different types.
+// Numeric conversions return ConversionError for values which overflow, lose sign or precision,
+// Convert method of arrays returns Convertible working in other ConversionMode
 type Convertible interface {
 	ToStringArray() (*[]string, error)
 	ToFloat32Array() (*[]float32, error)
@@ -0,0 +1,238 @@
+package arrays
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// Functions which need type parameters in addition to the element type, they accept any slice type,
+// so they work with Array, ComparableArray, OrderedArray and the legacy IntArray, FloatArray and StringArray
+
+// SortBy sorts array in place by keys returned by the given function, sort is not guaranteed to be stable
+//
+// users := []user{{name: "Bob", age: 42}, {name: "Alice", age: 30}}
+//
+// SortBy(users, func(u user) int { return u.age }) # => [{Alice 30}, {Bob 42}]
+func SortBy[S ~[]T, T any, K cmp.Ordered](arr S, key func(el T) K) {
+	slices.SortFunc(arr, byKey(key))
+}
+
+// SortStableBy sorts array in place by keys returned by the given function, elements with equal keys keep their original order
+func SortStableBy[S ~[]T, T any, K cmp.Ordered](arr S, key func(el T) K) {
+	slices.SortStableFunc(arr, byKey(key))
+}
+
+// SortedBy returns copy of array sorted by keys returned by the given function, elements with equal keys keep their original order
+func SortedBy[S ~[]T, T any, K cmp.Ordered](arr S, key func(el T) K) S {
+	sorted := slices.Clone(arr)
+	SortStableBy(sorted, key)
+
+	return sorted
+}
+
+// GroupBy groups elements by keys returned by the given function, elements of every group keep their original order
+//
+// words := []string{"apple", "avocado", "banana"}
+//
+// GroupBy(words, func(el string) byte { return el[0] }) # => {'a': ["apple", "avocado"], 'b': ["banana"]}
+func GroupBy[S ~[]T, T any, K comparable](arr S, key func(el T) K) map[K][]T {
+	groups := make(map[K][]T)
+
+	for _, el := range arr {
+		k := key(el)
+		groups[k] = append(groups[k], el)
+	}
+
+	return groups
+}
+
+// CountBy returns number of elements for every key returned by the given function
+//
+// words := []string{"apple", "avocado", "banana"}
+//
+// CountBy(words, func(el string) byte { return el[0] }) # => {'a': 2, 'b': 1}
+func CountBy[S ~[]T, T any, K comparable](arr S, key func(el T) K) map[K]int {
+	counts := make(map[K]int)
+
+	for _, el := range arr {
+		counts[key(el)]++
+	}
+
+	return counts
+}
+
+// IndexBy returns map of elements by keys returned by the given function, DuplicateKeyError is returned if two elements have the same key
+//
+// users := []user{{id: 1, name: "Bob"}, {id: 2, name: "Alice"}}
+//
+// IndexBy(users, func(u user) int { return u.id }) # => {1: {1 Bob}, 2: {2 Alice}}
+func IndexBy[S ~[]T, T any, K comparable](arr S, key func(el T) K) (map[K]T, error) {
+	index := make(map[K]T, len(arr))
+
+	for i, el := range arr {
+		k := key(el)
+		if _, ok := index[k]; ok {
+			return nil, &DuplicateKeyError{Key: k, Index: i}
+		}
+
+		index[k] = el
+	}
+
+	return index, nil
+}
+
+// DuplicateKeyError is returned by IndexBy when key is returned for more than one element, Index is the index of the second element
+type DuplicateKeyError struct {
+	Key   interface{}
+	Index int
+}
+
+func (e *DuplicateKeyError) Error() string {
+	return fmt.Sprintf("duplicate key %v for element %v", e.Key, e.Index)
+}
+
+// UniqBy removes elements for which the given function returns already seen key, first occurrence is kept so the order is preserved
+//
+// emails := []string{"Bob@example.com", "alice@example.com", "bob@example.com"}
+//
+// UniqBy(&emails, strings.ToLower) # => ["Bob@example.com", "alice@example.com"]
+func UniqBy[S ~[]T, T any, K comparable](arr *S, key func(el T) K) {
+	if len(*arr) == 0 {
+		return
+	}
+
+	seen := make(map[K]struct{}, len(*arr))
+	resArr := (*arr)[:0]
+
+	for _, el := range *arr {
+		k := key(el)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+
+		seen[k] = struct{}{}
+		resArr = append(resArr, el)
+	}
+
+	var zero T
+	for i := len(resArr); i < len(*arr); i++ {
+		(*arr)[i] = zero // Erase removed elements (write zero value).
+	}
+
+	*arr = resArr
+}
+
+// byKey returns comparison function which compares elements by keys returned by the given function
+func byKey[T any, K cmp.Ordered](key func(el T) K) func(a, b T) int {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// Summable is a constraint for element types which can be added with +, strings are concatenated
+type Summable interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// MapTo returns new array contained values returned by the provided function, unlike Map the type of values may differ from the type of elements
+//
+// ids := []int{1, 2}
+//
+// MapTo(ids, strconv.Itoa) # => ["1", "2"]
+func MapTo[S ~[]T, T any, R any](arr S, exec func(el T) R) []R {
+	resArr := make([]R, 0, len(arr))
+	for _, el := range arr {
+		resArr = append(resArr, exec(el))
+	}
+
+	return resArr
+}
+
+// FlatMap returns new array contained elements of arrays returned by the provided function
+//
+// lines := []string{"a b", "c"}
+//
+// FlatMap(lines, strings.Fields) # => ["a", "b", "c"]
+func FlatMap[S ~[]T, T any, R any](arr S, exec func(el T) []R) []R {
+	resArr := make([]R, 0, len(arr))
+	for _, el := range arr {
+		resArr = append(resArr, exec(el)...)
+	}
+
+	return resArr
+}
+
+// FilterMap returns values returned by the provided function for which it also returns true, so mapping and filtering is done in one pass
+//
+// strs := []string{"1", "x", "3"}
+//
+// FilterMap(strs, func(el string) (int, bool) { n, err := strconv.Atoi(el); return n, err == nil }) # => [1, 3]
+func FilterMap[S ~[]T, T any, R any](arr S, exec func(el T) (R, bool)) []R {
+	resArr := make([]R, 0)
+	for _, el := range arr {
+		if r, ok := exec(el); ok {
+			resArr = append(resArr, r)
+		}
+	}
+
+	return resArr
+}
+
+// Reduce combines all elements with the provided function starting from init value
+//
+// words := []string{"a", "bc"}
+//
+// Reduce(words, 0, func(n int, el string) int { return n + len(el) }) # => 3
+func Reduce[S ~[]T, T any, A any](arr S, init A, exec func(acc A, el T) A) A {
+	acc := init
+	for _, el := range arr {
+		acc = exec(acc, el)
+	}
+
+	return acc
+}
+
+// Inject combines all elements with the provided function starting from the first element, nil is returned for empty array
+//
+// Inject([]int{2, 3, 4}, func(acc, el int) int { return acc * el }) # => 24
+func Inject[S ~[]T, T any](arr S, exec func(acc T, el T) T) *T {
+	if len(arr) == 0 {
+		return nil
+	}
+
+	acc := Reduce(arr[1:], arr[0], exec)
+
+	return &acc
+}
+
+// Sum returns sum of elements, strings are concatenated, zero value is returned for empty array
+//
+// Sum([]int{1, 2, 3}) # => 6
+func Sum[S ~[]T, T Summable](arr S) T {
+	return SumFunc(arr, func(a, b T) T { return a + b })
+}
+
+// SumFunc returns sum of elements calculated with the provided adder, zero value is returned for empty array
+//
+// durations := []time.Duration{time.Second, time.Minute}
+//
+// SumFunc(durations, func(a, b time.Duration) time.Duration { return a + b }) # => 1m1s
+func SumFunc[S ~[]T, T any](arr S, add func(a, b T) T) T {
+	var zero T
+
+	return Reduce(arr, zero, add)
+}
+
+// EachWithObject calls the provided function for every element with the given object and returns the object
+//
+// byLength := EachWithObject(words, map[int][]string{}, func(el string, m map[int][]string) { m[len(el)] = append(m[len(el)], el) })
+func EachWithObject[S ~[]T, T any, O any](arr S, obj O, exec func(el T, obj O)) O {
+	for _, el := range arr {
+		exec(el, obj)
+	}
+
+	return obj
+}
@@ -0,0 +1,239 @@
+package arrays
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUniqBy(t *testing.T) {
+	type testData struct {
+		arr      []string
+		key      func(el string) string
+		response []string
+	}
+
+	examples := map[string]testData{
+		"empty arr":        testData{arr: []string{}, key: strings.ToLower, response: []string{}},
+		"case insensitive": testData{arr: []string{"Bob@example.com", "alice@example.com", "bob@example.com", "ALICE@example.com"}, key: strings.ToLower, response: []string{"Bob@example.com", "alice@example.com"}},
+		"by domain": testData{arr: []string{"a@x.com", "b@y.com", "c@x.com"}, key: func(el string) string { return el[strings.Index(el, "@"):] },
+			response: []string{"a@x.com", "b@y.com"}},
+	}
+
+	for k, v := range examples {
+		initialArr := Array[string](v.arr)
+		UniqBy(&initialArr, v.key)
+
+		if !reflect.DeepEqual([]string(initialArr), v.response) {
+			t.Errorf("test [%v] failed on method UniqBy with params(initialArr: %v), expected to be %v got %v",
+				k, v.arr, v.response, initialArr)
+		}
+	}
+
+	structs := []point{{x: 1, y: 1}, {x: 1, y: 2}, {x: 2, y: 1}}
+	UniqBy(&structs, func(el point) int { return el.x })
+
+	response := []point{{x: 1, y: 1}, {x: 2, y: 1}}
+	if !reflect.DeepEqual(structs, response) {
+		t.Errorf("test [plain slice] failed on method UniqBy, expected to be %v got %v", response, structs)
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	type testData struct {
+		arr      []string
+		key      func(el string) int
+		response []string
+	}
+
+	examples := map[string]testData{
+		"empty arr": testData{arr: []string{}, key: func(el string) int { return len(el) }, response: []string{}},
+		"by length": testData{arr: []string{"pear", "fig", "apple", "kiwi"}, key: func(el string) int { return len(el) }, response: []string{"fig", "pear", "kiwi", "apple"}},
+		"by last letter": testData{arr: []string{"ab", "ba", "cb", "aa"}, key: func(el string) int { return int(el[len(el)-1]) },
+			response: []string{"ba", "aa", "ab", "cb"}},
+	}
+
+	for k, v := range examples {
+		initialArr := Array[string](slices.Clone(v.arr))
+
+		resp := SortedBy(initialArr, v.key)
+		if !reflect.DeepEqual([]string(resp), v.response) || !reflect.DeepEqual([]string(initialArr), v.arr) {
+			t.Errorf("test [%v] failed on method SortedBy with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.response, resp)
+		}
+
+		SortStableBy(initialArr, v.key)
+		if !reflect.DeepEqual([]string(initialArr), v.response) {
+			t.Errorf("test [%v] failed on method SortStableBy with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.response, initialArr)
+		}
+	}
+
+	structs := []point{{x: 3}, {x: 1}, {x: 2}}
+	SortBy(structs, func(el point) int { return el.x })
+
+	response := []point{{x: 1}, {x: 2}, {x: 3}}
+	if !reflect.DeepEqual(structs, response) {
+		t.Errorf("test [plain slice] failed on method SortBy, expected to be %v got %v", response, structs)
+	}
+}
+
+func TestGroupByAndCountBy(t *testing.T) {
+	type testData struct {
+		arr    []string
+		groups map[byte][]string
+		counts map[byte]int
+	}
+
+	firstLetter := func(el string) byte { return el[0] }
+
+	examples := map[string]testData{
+		"empty arr": testData{arr: []string{}, groups: map[byte][]string{}, counts: map[byte]int{}},
+		"multiple elements": testData{arr: []string{"apple", "banana", "avocado", "blueberry", "cherry"},
+			groups: map[byte][]string{'a': {"apple", "avocado"}, 'b': {"banana", "blueberry"}, 'c': {"cherry"}},
+			counts: map[byte]int{'a': 2, 'b': 2, 'c': 1}},
+	}
+
+	for k, v := range examples {
+		groups := GroupBy(v.arr, firstLetter)
+		if !reflect.DeepEqual(groups, v.groups) {
+			t.Errorf("test [%v] failed on method GroupBy with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.groups, groups)
+		}
+
+		counts := CountBy(v.arr, firstLetter)
+		if !reflect.DeepEqual(counts, v.counts) {
+			t.Errorf("test [%v] failed on method CountBy with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.counts, counts)
+		}
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	points := Array[point]{{x: 1, y: 1}, {x: 2, y: 2}}
+
+	index, err := IndexBy(points, func(el point) int { return el.x })
+	if err != nil || len(index) != 2 || index[2].y != 2 {
+		t.Errorf("test [unique keys] failed on method IndexBy, expected map of 2 points got %v and error %v", index, err)
+	}
+
+	points.Push(point{x: 1, y: 3})
+	index, err = IndexBy(points, func(el point) int { return el.x })
+
+	var dupErr *DuplicateKeyError
+	if index != nil || !errors.As(err, &dupErr) || dupErr.Key != 1 || dupErr.Index != 2 {
+		t.Errorf("test [duplicate keys] failed on method IndexBy, expected DuplicateKeyError for key 1 at index 2 got %v and error %v", index, err)
+	}
+}
+
+func TestMapToAndFlatMap(t *testing.T) {
+	ids := ComparableArray[int]{1, 22, 333}
+
+	strs := MapTo(ids, strconv.Itoa)
+	if !reflect.DeepEqual(strs, []string{"1", "22", "333"}) {
+		t.Errorf("test [ints to strings] failed on method MapTo, expected to be %v got %v", []string{"1", "22", "333"}, strs)
+	}
+
+	points := MapTo(ids, func(el int) point { return point{x: el} })
+	if len(points) != 3 || points[2].x != 333 {
+		t.Errorf("test [ints to structs] failed on method MapTo, expected 3 points got %v", points)
+	}
+
+	if resp := MapTo([]int{}, strconv.Itoa); !reflect.DeepEqual(resp, []string{}) {
+		t.Errorf("test [empty arr] failed on method MapTo, expected to be empty got %v", resp)
+	}
+
+	words := FlatMap([]string{"a b", "", "c"}, strings.Fields)
+	if !reflect.DeepEqual(words, []string{"a", "b", "c"}) {
+		t.Errorf("test [words] failed on method FlatMap, expected to be %v got %v", []string{"a", "b", "c"}, words)
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	type testData struct {
+		arr      []string
+		response []int
+	}
+
+	atoi := func(el string) (int, bool) {
+		n, err := strconv.Atoi(el)
+		return n, err == nil
+	}
+
+	examples := map[string]testData{
+		"empty arr":      testData{arr: []string{}, response: []int{}},
+		"all valid":      testData{arr: []string{"1", "0"}, response: []int{1, 0}},
+		"invalid values": testData{arr: []string{"1", "x", "3", ""}, response: []int{1, 3}},
+	}
+
+	for k, v := range examples {
+		resp := FilterMap(v.arr, atoi)
+
+		if !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed on method FilterMap with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.response, resp)
+		}
+	}
+}
+
+func TestReduceAndInject(t *testing.T) {
+	words := Array[string]{"a", "bc", "def"}
+
+	if resp := Reduce(words, 0, func(n int, el string) int { return n + len(el) }); resp != 6 {
+		t.Errorf("test [total length] failed on method Reduce, expected to be 6 got %v", resp)
+	}
+
+	if resp := Reduce([]string{}, 7, func(n int, el string) int { return n + len(el) }); resp != 7 {
+		t.Errorf("test [empty arr] failed on method Reduce, expected to be 7 got %v", resp)
+	}
+
+	product := func(acc, el int) int { return acc * el }
+	if resp := Inject([]int{2, 3, 4}, product); resp == nil || *resp != 24 {
+		t.Errorf("test [product] failed on method Inject, expected to be 24 got %v", resp)
+	}
+
+	if resp := Inject([]int{5}, product); resp == nil || *resp != 5 {
+		t.Errorf("test [one element] failed on method Inject, expected to be 5 got %v", resp)
+	}
+
+	if resp := Inject([]int{}, product); resp != nil {
+		t.Errorf("test [empty arr] failed on method Inject, expected to be nil got %v", *resp)
+	}
+}
+
+func TestSum(t *testing.T) {
+	if resp := Sum(OrderedArray[int]{1, 2, 3}); resp != 6 {
+		t.Errorf("test [ints] failed on method Sum, expected to be 6 got %v", resp)
+	}
+
+	if resp := Sum([]float64{}); resp != 0 {
+		t.Errorf("test [empty arr] failed on method Sum, expected to be 0 got %v", resp)
+	}
+
+	if resp := Sum([]string{"a", "b", "c"}); resp != "abc" {
+		t.Errorf("test [strings] failed on method Sum, expected to be abc got %v", resp)
+	}
+
+	durations := []time.Duration{time.Second, time.Minute}
+	if resp := SumFunc(durations, func(a, b time.Duration) time.Duration { return a + b }); resp != 61*time.Second {
+		t.Errorf("test [durations] failed on method SumFunc, expected to be 1m1s got %v", resp)
+	}
+
+	points := []point{{x: 1, y: 2}, {x: 3, y: 4}}
+	resp := SumFunc(points, func(a, b point) point { return point{x: a.x + b.x, y: a.y + b.y} })
+	if resp.x != 4 || resp.y != 6 {
+		t.Errorf("test [points] failed on method SumFunc, expected to be {4 6} got %v", resp)
+	}
+}
+
+func TestEachWithObject(t *testing.T) {
+	words := []string{"a", "bc", "d"}
+
+	resp := EachWithObject(words, map[int][]string{}, func(el string, m map[int][]string) {
+		m[len(el)] = append(m[len(el)], el)
+	})
+
+	response := map[int][]string{1: {"a", "d"}, 2: {"bc"}}
+	if !reflect.DeepEqual(resp, response) {
+		t.Errorf("test [group by length] failed on method EachWithObject, expected to be %v got %v", response, resp)
+	}
+}
@@ -0,0 +1,224 @@
+package lazy
+
+import "iter"
+
+// Enumerator is a lazy sequence of elements, its methods build a pipeline which evaluates one element at a time
+// when a terminal method (ToArray, First, Reduce) is called or the sequence is iterated with range over Seq.
+// Enumerators built from slices and functions may be iterated many times, enumerators built from channels only once
+//
+// FromSlice(arr).Filter(isEven).Map(double).First(3) # => evaluates elements only until 3 even numbers are found
+type Enumerator[T any] struct {
+	seq iter.Seq[T]
+}
+
+// FromSeq returns Enumerator which iterates over the given sequence
+func FromSeq[T any](seq iter.Seq[T]) *Enumerator[T] {
+	return &Enumerator[T]{seq: seq}
+}
+
+// FromSlice returns Enumerator which iterates over elements of array, it accepts any slice type including typed arrays
+func FromSlice[S ~[]T, T any](arr S) *Enumerator[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for _, el := range arr {
+			if !yield(el) {
+				return
+			}
+		}
+	})
+}
+
+// FromChan returns Enumerator which receives elements from channel until it is closed
+func FromChan[T any](ch <-chan T) *Enumerator[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for el := range ch {
+			if !yield(el) {
+				return
+			}
+		}
+	})
+}
+
+// FromFunc returns Enumerator which calls the given generator function until it returns false
+//
+// i := 0
+//
+// FromFunc(func() (int, bool) { i++; return i, true }).First(3) # => [1, 2, 3]
+func FromFunc[T any](next func() (T, bool)) *Enumerator[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for {
+			el, ok := next()
+			if !ok || !yield(el) {
+				return
+			}
+		}
+	})
+}
+
+// Seq returns sequence of elements which can be iterated with range
+func (e *Enumerator[T]) Seq() iter.Seq[T] {
+	return e.seq
+}
+
+// Map returns Enumerator of values returned by the provided function, use package function Map to change the type of elements
+func (e *Enumerator[T]) Map(exec func(el T) T) *Enumerator[T] {
+	return Map(e, exec)
+}
+
+// Filter returns Enumerator of elements for which the given function returns true
+func (e *Enumerator[T]) Filter(exec func(el T) bool) *Enumerator[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for el := range e.seq {
+			if exec(el) && !yield(el) {
+				return
+			}
+		}
+	})
+}
+
+// Take returns Enumerator of the first n elements, elements after them are never evaluated
+func (e *Enumerator[T]) Take(n int) *Enumerator[T] {
+	return FromSeq(func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+
+		i := 0
+		for el := range e.seq {
+			if !yield(el) {
+				return
+			}
+
+			i++
+			if i >= n {
+				return
+			}
+		}
+	})
+}
+
+// TakeWhile returns Enumerator of elements before the first element for which the given function returns false
+func (e *Enumerator[T]) TakeWhile(exec func(el T) bool) *Enumerator[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for el := range e.seq {
+			if !exec(el) || !yield(el) {
+				return
+			}
+		}
+	})
+}
+
+// Drop returns Enumerator which skips the first n elements
+func (e *Enumerator[T]) Drop(n int) *Enumerator[T] {
+	return FromSeq(func(yield func(T) bool) {
+		i := 0
+		for el := range e.seq {
+			if i < n {
+				i++
+				continue
+			}
+
+			if !yield(el) {
+				return
+			}
+		}
+	})
+}
+
+// DropWhile returns Enumerator which skips elements until the given function returns false for the first time
+func (e *Enumerator[T]) DropWhile(exec func(el T) bool) *Enumerator[T] {
+	return FromSeq(func(yield func(T) bool) {
+		dropping := true
+		for el := range e.seq {
+			if dropping && exec(el) {
+				continue
+			}
+
+			dropping = false
+			if !yield(el) {
+				return
+			}
+		}
+	})
+}
+
+// FlatMap returns Enumerator of elements of arrays returned by the provided function
+func (e *Enumerator[T]) FlatMap(exec func(el T) []T) *Enumerator[T] {
+	return FlatMap(e, exec)
+}
+
+// WithIndex returns sequence of elements together with their indexes
+//
+// for i, el := range FromSlice(arr).Filter(isEven).WithIndex() { ... }
+func (e *Enumerator[T]) WithIndex() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for el := range e.seq {
+			if !yield(i, el) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Reduce combines all elements with the given function starting from init value, use package function Reduce to change the type of result
+//
+// FromSlice([]int{1, 2, 3}).Reduce(0, func(sum, el int) int { return sum + el }) # => 6
+func (e *Enumerator[T]) Reduce(init T, exec func(acc T, el T) T) T {
+	return Reduce(e, init, exec)
+}
+
+// First returns array of the first n elements, elements after them are never evaluated
+func (e *Enumerator[T]) First(n int) []T {
+	return e.Take(n).ToArray()
+}
+
+// ToArray evaluates all elements and returns them as array
+func (e *Enumerator[T]) ToArray() []T {
+	arr := make([]T, 0)
+	for el := range e.seq {
+		arr = append(arr, el)
+	}
+
+	return arr
+}
+
+// Map returns Enumerator of values returned by the provided function
+//
+// Map(FromSlice([]int{1, 2}), strconv.Itoa).ToArray() # => ["1", "2"]
+func Map[T any, R any](e *Enumerator[T], exec func(el T) R) *Enumerator[R] {
+	return FromSeq(func(yield func(R) bool) {
+		for el := range e.seq {
+			if !yield(exec(el)) {
+				return
+			}
+		}
+	})
+}
+
+// FlatMap returns Enumerator of elements of arrays returned by the provided function
+//
+// FlatMap(FromSlice([]string{"a b", "c"}), strings.Fields).ToArray() # => ["a", "b", "c"]
+func FlatMap[T any, R any](e *Enumerator[T], exec func(el T) []R) *Enumerator[R] {
+	return FromSeq(func(yield func(R) bool) {
+		for el := range e.seq {
+			for _, r := range exec(el) {
+				if !yield(r) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// Reduce combines all elements with the given function starting from init value
+//
+// Reduce(FromSlice([]string{"a", "bc"}), 0, func(n int, el string) int { return n + len(el) }) # => 3
+func Reduce[T any, A any](e *Enumerator[T], init A, exec func(acc A, el T) A) A {
+	acc := init
+	for el := range e.seq {
+		acc = exec(acc, el)
+	}
+
+	return acc
+}
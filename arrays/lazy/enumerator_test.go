@@ -0,0 +1,113 @@
+package lazy
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestEnumeratorPipeline(t *testing.T) {
+	type testData struct {
+		enumerator *Enumerator[int]
+		response   []int
+	}
+
+	arr := []int{1, 2, 3, 4, 5, 6}
+	isEven := func(el int) bool { return el%2 == 0 }
+	lessThan := func(n int) func(el int) bool { return func(el int) bool { return el < n } }
+
+	examples := map[string]testData{
+		"to array":            testData{enumerator: FromSlice(arr), response: arr},
+		"empty arr":           testData{enumerator: FromSlice([]int{}).Map(func(el int) int { return el * 2 }), response: []int{}},
+		"filter":              testData{enumerator: FromSlice(arr).Filter(isEven), response: []int{2, 4, 6}},
+		"map":                 testData{enumerator: FromSlice(arr).Map(func(el int) int { return el * 10 }), response: []int{10, 20, 30, 40, 50, 60}},
+		"take":                testData{enumerator: FromSlice(arr).Take(2), response: []int{1, 2}},
+		"take zero":           testData{enumerator: FromSlice(arr).Take(0), response: []int{}},
+		"take more":           testData{enumerator: FromSlice(arr).Take(10), response: arr},
+		"take while":          testData{enumerator: FromSlice(arr).TakeWhile(lessThan(3)), response: []int{1, 2}},
+		"drop":                testData{enumerator: FromSlice(arr).Drop(4), response: []int{5, 6}},
+		"drop more":           testData{enumerator: FromSlice(arr).Drop(10), response: []int{}},
+		"drop while":          testData{enumerator: FromSlice([]int{1, 2, 5, 1}).DropWhile(lessThan(3)), response: []int{5, 1}},
+		"flat map":            testData{enumerator: FromSlice([]int{1, 2}).FlatMap(func(el int) []int { return []int{el, -el} }), response: []int{1, -1, 2, -2}},
+		"filter map and take": testData{enumerator: FromSlice(arr).Filter(isEven).Map(func(el int) int { return el + 1 }).Take(2), response: []int{3, 5}},
+	}
+
+	for k, v := range examples {
+		resp := v.enumerator.ToArray()
+
+		if !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed on method ToArray, expected to be %v got %v", k, v.response, resp)
+		}
+	}
+}
+
+func TestEnumeratorIsLazy(t *testing.T) {
+	evaluated := 0
+	i := 0
+	naturals := FromFunc(func() (int, bool) {
+		evaluated++
+		i++
+		return i, true
+	})
+
+	resp := naturals.Filter(func(el int) bool { return el%3 == 0 }).Map(func(el int) int { return el * el }).First(2)
+	if !reflect.DeepEqual(resp, []int{9, 36}) {
+		t.Errorf("test [infinite generator] failed on method First, expected to be %v got %v", []int{9, 36}, resp)
+	}
+
+	if evaluated != 6 {
+		t.Errorf("test [infinite generator] failed, expected 6 elements to be evaluated got %v", evaluated)
+	}
+
+	mapped := 0
+	FromSlice([]int{1, 2, 3, 4}).Map(func(el int) int { mapped++; return el }).TakeWhile(func(el int) bool { return el < 2 }).ToArray()
+	if mapped != 2 {
+		t.Errorf("test [take while] failed, expected 2 elements to be mapped got %v", mapped)
+	}
+}
+
+func TestEnumeratorFromChan(t *testing.T) {
+	ch := make(chan string, 3)
+	ch <- "a"
+	ch <- "bb"
+	ch <- "ccc"
+	close(ch)
+
+	resp := Map(FromChan(ch), func(el string) int { return len(el) }).ToArray()
+	if !reflect.DeepEqual(resp, []int{1, 2, 3}) {
+		t.Errorf("test [channel] failed on method Map, expected to be %v got %v", []int{1, 2, 3}, resp)
+	}
+}
+
+func TestEnumeratorWithIndex(t *testing.T) {
+	indexes, values := []int{}, []string{}
+	for i, el := range FromSlice([]string{"a", "b", "c", "d"}).Drop(1).WithIndex() {
+		if i == 2 {
+			break
+		}
+		indexes = append(indexes, i)
+		values = append(values, el)
+	}
+
+	if !reflect.DeepEqual(indexes, []int{0, 1}) || !reflect.DeepEqual(values, []string{"b", "c"}) {
+		t.Errorf("test [with index] failed on method WithIndex, expected to be [0 1] [b c] got %v %v", indexes, values)
+	}
+}
+
+func TestEnumeratorReduce(t *testing.T) {
+	sum := FromSlice([]int{1, 2, 3}).Reduce(0, func(acc, el int) int { return acc + el })
+	if sum != 6 {
+		t.Errorf("test [sum] failed on method Reduce, expected to be %v got %v", 6, sum)
+	}
+
+	joined := Reduce(FromSlice([]int{1, 2, 3}), "", func(acc string, el int) string { return acc + strconv.Itoa(el) })
+	if joined != "123" {
+		t.Errorf("test [join] failed on method Reduce, expected to be %v got %v", "123", joined)
+	}
+
+	words := FlatMap(FromSlice([]string{"a b", "", "c"}), strings.Fields).ToArray()
+	if !reflect.DeepEqual(words, []string{"a", "b", "c"}) {
+		t.Errorf("test [words] failed on method FlatMap, expected to be %v got %v", []string{"a", "b", "c"}, words)
+	}
+}
@@ -3,6 +3,7 @@ package arrays
 import (
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/maki5/rutils"
@@ -144,7 +145,7 @@ func TestCompact(t *testing.T) {
 
 	for k, v := range examples {
 		initialArr := StringArray(v.arr)
-		initialArr.Compact()
+		Compact(&initialArr)
 
 		if !reflect.DeepEqual([]string(initialArr), v.response) {
 			t.Errorf("test [%v] failed on method Collect with params(initialArr: %v), expected to be %v got %v",
@@ -196,7 +197,7 @@ func TestIndex(t *testing.T) {
 
 	for k, v := range examples {
 		initialArr := StringArray(v.arr)
-		resp := initialArr.Index(v.selector)
+		resp := Index(initialArr, v.selector)
 
 		if resp != v.response {
 			t.Errorf("test [%v] failed on method Index with params(initialArr: %v, selector: %v), expected to be %v got %v",
@@ -221,7 +222,7 @@ func TestMin(t *testing.T) {
 
 	for k, v := range examples {
 		initialArr := StringArray(v.arr)
-		resp := initialArr.Min()
+		resp := Min(initialArr)
 
 		if resp != v.response {
 			t.Errorf("test [%v] failed on method Min with params(initialArr: %v), expected to be %v got %v",
@@ -246,7 +247,7 @@ func TestMax(t *testing.T) {
 
 	for k, v := range examples {
 		initialArr := StringArray(v.arr)
-		resp := initialArr.Max()
+		resp := Max(initialArr)
 
 		if resp != v.response {
 			t.Errorf("test [%v] failed on method Max with params(initialArr: %v), expected to be %v got %v",
@@ -275,9 +276,9 @@ func TestPop(t *testing.T) {
 		initialArr := StringArray(v.arr)
 		var resp string
 		if v.n != nil {
-			resp = initialArr.Pop(*v.n)
+			resp = Pop(&initialArr, *v.n)
 		} else {
-			resp = initialArr.Pop()
+			resp = Pop(&initialArr)
 		}
 
 		if resp != v.response {
@@ -364,6 +365,22 @@ func TestUniq(t *testing.T) {
 
 }
 
+func TestUniqKeepsOrder(t *testing.T) {
+	initialArr := StringArray{"str3", "str1", "str3", "str2", "str1", "str4"}
+	response := []string{"str3", "str1", "str2", "str4"}
+
+	for i := 0; i < 10; i++ {
+		arr := make(StringArray, len(initialArr))
+		copy(arr, initialArr)
+		arr.Uniq()
+
+		if !reflect.DeepEqual([]string(arr), response) {
+			t.Errorf("test [keeps order] failed on method Uniq with params(initialArr: %v), expected to be %v got %v",
+				initialArr, response, arr)
+		}
+	}
+}
+
 func TestToStringArray(t *testing.T) {
 	type testData struct {
 		arr      []string
@@ -582,3 +599,103 @@ func TestToJson(t *testing.T) {
 	}
 
 }
+
+func TestSort(t *testing.T) {
+	type testData struct {
+		arr  []string
+		asc  []string
+		desc []string
+	}
+
+	examples := map[string]testData{
+		"empty arr":         testData{arr: []string{}, asc: []string{}, desc: []string{}},
+		"multiple elements": testData{arr: []string{"b", "C", "a", "b"}, asc: []string{"C", "a", "b", "b"}, desc: []string{"b", "b", "a", "C"}},
+	}
+
+	for k, v := range examples {
+		initialArr := StringArray(append([]string{}, v.arr...))
+
+		if resp := initialArr.Sorted(); !reflect.DeepEqual(resp, v.asc) || !reflect.DeepEqual([]string(initialArr), v.arr) {
+			t.Errorf("test [%v] failed on method Sorted with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.asc, resp)
+		}
+
+		initialArr.SortDesc()
+		if !reflect.DeepEqual([]string(initialArr), v.desc) {
+			t.Errorf("test [%v] failed on method SortDesc with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.desc, initialArr)
+		}
+
+		initialArr.Sort()
+		if !reflect.DeepEqual([]string(initialArr), v.asc) {
+			t.Errorf("test [%v] failed on method Sort with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.asc, initialArr)
+		}
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	type testData struct {
+		arr []string
+		cmp func(a, b string) int
+		min string
+		max string
+	}
+
+	caseInsensitive := func(a, b string) int { return Collate(a, b, CaseInsensitive) }
+
+	examples := map[string]testData{
+		"empty arr":        testData{arr: []string{}, cmp: strings.Compare, min: "", max: ""},
+		"binary":           testData{arr: []string{"pear", "Fig", "apple"}, cmp: strings.Compare, min: "Fig", max: "pear"},
+		"case insensitive": testData{arr: []string{"pear", "Fig", "apple", "Zoo"}, cmp: caseInsensitive, min: "apple", max: "Zoo"},
+	}
+
+	for k, v := range examples {
+		initialArr := StringArray(v.arr)
+
+		if resp := initialArr.MinBy(v.cmp); !equalPtr(resp, v.min) {
+			t.Errorf("test [%v] failed on method MinBy with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.min, resp)
+		}
+
+		if resp := initialArr.MaxBy(v.cmp); !equalPtr(resp, v.max) {
+			t.Errorf("test [%v] failed on method MaxBy with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.max, resp)
+		}
+	}
+}
+
+// equalPtr checks if pointer points to the given string, nil pointer is equal to empty string
+func equalPtr(ptr *string, str string) bool {
+	if ptr == nil {
+		return str == ""
+	}
+
+	return *ptr == str
+}
+
+func TestTallyAndPartition(t *testing.T) {
+	initialArr := StringArray{"a", "", "b", "a"}
+
+	tally := initialArr.Tally()
+	if !reflect.DeepEqual(tally, map[string]int{"a": 2, "": 1, "b": 1}) {
+		t.Errorf("test [tally] failed on method Tally with params(initialArr: %v), got %v", initialArr, tally)
+	}
+
+	present, blank := initialArr.Partition(func(el string) bool { return !rutils.Blank(el) })
+	if !reflect.DeepEqual(present, []string{"a", "b", "a"}) || !reflect.DeepEqual(blank, []string{""}) {
+		t.Errorf("test [partition] failed on method Partition with params(initialArr: %v), got %v, %v", initialArr, present, blank)
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	before := StringArray{"alice", "bob", "carol"}
+	after := []string{"bob", "carol", "dave"}
+
+	if added := (*StringArray)(&after).Difference(before); !reflect.DeepEqual(added, []string{"dave"}) {
+		t.Errorf("test [added] failed on method Difference, expected to be %v got %v", []string{"dave"}, added)
+	}
+
+	if removed := before.Difference(after); !reflect.DeepEqual(removed, []string{"alice"}) {
+		t.Errorf("test [removed] failed on method Difference, expected to be %v got %v", []string{"alice"}, removed)
+	}
+
+	if !before.Intersects(after) || before.IsSubsetOf(after) {
+		t.Errorf("test [predicates] failed on methods Intersects and IsSubsetOf with params(initialArr: %v, other: %v)", before, after)
+	}
+}
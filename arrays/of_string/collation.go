@@ -0,0 +1,147 @@
+package arrays
+
+import (
+	"cmp"
+	"slices"
+	strings2 "strings"
+	"unicode/utf8"
+
+	rutilsStrings "github.com/maki5/rutils/strings"
+)
+
+// Collation defines how strings are compared while sorting, collations can be combined: Natural | CaseInsensitive
+type Collation int
+
+// Binary compares strings byte by byte, it is used by Sort
+const Binary Collation = 0
+
+const (
+	// CaseInsensitive ignores case of letters
+	CaseInsensitive Collation = 1 << iota
+	// Natural compares sequences of digits by their numeric value, so "file2" goes before "file10"
+	Natural
+	// Locale ignores case and diacritics, so "école" goes between "eagle" and "ether".
+	// Letters are compared by their transliteration which may be customized for the locale
+	Locale
+)
+
+// Collate compares strings using the given collation, it returns negative number when a < b,
+// positive number when a > b and zero only when strings are equal. Strings which are equal according to collation
+// are compared byte by byte, so the result doesn't depend on the order of elements
+//
+// Collate("file10", "file2", Natural)           # => 1
+//
+// Collate("Bob", "alice", CaseInsensitive)      # => 1
+//
+// Collate("Ärger", "Afrika", Locale)            # => 1
+//
+// Collate("Ärger", "Afrika", Locale, "de")      # => -1, "Ärger" is compared as "aerger"
+func Collate(a, b string, collation Collation, locale ...string) int {
+	return compareCollated(collated{str: a, key: collationKey(a, collation, locale)},
+		collated{str: b, key: collationKey(b, collation, locale)}, collation)
+}
+
+// collated keeps string together with its collation key, so the key is calculated once while sorting
+type collated struct {
+	str string
+	key string
+}
+
+func sortCollated(arr []string, collation Collation, locale []string) {
+	if collation == Binary {
+		slices.Sort(arr)
+		return
+	}
+
+	keys := make([]collated, 0, len(arr))
+	for _, el := range arr {
+		keys = append(keys, collated{str: el, key: collationKey(el, collation, locale)})
+	}
+
+	slices.SortFunc(keys, func(a, b collated) int {
+		return compareCollated(a, b, collation)
+	})
+
+	for i, el := range keys {
+		arr[i] = el.str
+	}
+}
+
+func collationKey(str string, collation Collation, locale []string) string {
+	if collation&Locale != 0 {
+		return strings2.ToLower(rutilsStrings.Transliterate(str, locale...))
+	}
+
+	if collation&CaseInsensitive != 0 {
+		return strings2.ToLower(str)
+	}
+
+	return str
+}
+
+func compareCollated(a, b collated, collation Collation) int {
+	var c int
+	if collation&Natural != 0 {
+		c = naturalCompare(a.key, b.key)
+	} else {
+		c = strings2.Compare(a.key, b.key)
+	}
+
+	if c != 0 {
+		return c
+	}
+
+	return strings2.Compare(a.str, b.str)
+}
+
+// naturalCompare compares strings rune by rune, sequences of digits are compared by their numeric value
+func naturalCompare(a, b string) int {
+	for a != "" && b != "" {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			aNum, aRest := splitDigits(a)
+			bNum, bRest := splitDigits(b)
+
+			if c := compareNumbers(aNum, bNum); c != 0 {
+				return c
+			}
+
+			a, b = aRest, bRest
+			continue
+		}
+
+		aRune, aSize := utf8.DecodeRuneInString(a)
+		bRune, bSize := utf8.DecodeRuneInString(b)
+		if aRune != bRune {
+			return cmp.Compare(aRune, bRune)
+		}
+
+		a, b = a[aSize:], b[bSize:]
+	}
+
+	return cmp.Compare(len(a), len(b))
+}
+
+// compareNumbers compares numbers of any length written with digits, leading zeros are ignored
+func compareNumbers(a, b string) int {
+	a = strings2.TrimLeft(a, "0")
+	b = strings2.TrimLeft(b, "0")
+
+	if len(a) != len(b) {
+		return cmp.Compare(len(a), len(b))
+	}
+
+	return strings2.Compare(a, b)
+}
+
+func splitDigits(str string) (string, string) {
+	i := 0
+	for i < len(str) && isDigit(str[i]) {
+		i++
+	}
+
+	return str[:i], str[i:]
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
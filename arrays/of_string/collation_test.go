@@ -0,0 +1,82 @@
+package arrays
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortWith(t *testing.T) {
+	type testData struct {
+		arr       []string
+		collation Collation
+		locale    []string
+		response  []string
+	}
+
+	examples := map[string]testData{
+		"empty arr":        testData{arr: []string{}, collation: Natural, response: []string{}},
+		"binary":           testData{arr: []string{"b", "a", "C", "file10", "file2"}, collation: Binary, response: []string{"C", "a", "b", "file10", "file2"}},
+		"case insensitive": testData{arr: []string{"b", "a", "C", "B"}, collation: CaseInsensitive, response: []string{"a", "B", "b", "C"}},
+		"natural": testData{arr: []string{"file10.txt", "file2.txt", "file1.txt", "file02.txt", "File3.txt"}, collation: Natural,
+			response: []string{"File3.txt", "file1.txt", "file02.txt", "file2.txt", "file10.txt"}},
+		"natural case insensitive": testData{arr: []string{"file10", "File2", "file1"}, collation: Natural | CaseInsensitive,
+			response: []string{"file1", "File2", "file10"}},
+		"natural long numbers": testData{arr: []string{"v100000000000000000000", "v99999999999999999999", "v9"}, collation: Natural,
+			response: []string{"v9", "v99999999999999999999", "v100000000000000000000"}},
+		"locale":        testData{arr: []string{"ether", "école", "Eagle", "zebra"}, collation: Locale, response: []string{"Eagle", "école", "ether", "zebra"}},
+		"german locale": testData{arr: []string{"Ärger", "Apfel", "Afrika"}, collation: Locale, locale: []string{"de"}, response: []string{"Ärger", "Afrika", "Apfel"}},
+	}
+
+	for k, v := range examples {
+		initialArr := StringArray(append([]string{}, v.arr...))
+
+		resp := SortedWith(initialArr, v.collation, v.locale...)
+		if !reflect.DeepEqual(resp, v.response) || !reflect.DeepEqual([]string(initialArr), v.arr) {
+			t.Errorf("test [%v] failed on method SortedWith with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.response, resp)
+		}
+
+		SortWith(initialArr, v.collation, v.locale...)
+		if !reflect.DeepEqual([]string(initialArr), v.response) {
+			t.Errorf("test [%v] failed on method SortWith with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.response, initialArr)
+		}
+	}
+}
+
+func TestCollate(t *testing.T) {
+	type testData struct {
+		a, b      string
+		collation Collation
+		response  int
+	}
+
+	examples := map[string]testData{
+		"equal strings":              testData{a: "abc", b: "abc", collation: Natural | Locale, response: 0},
+		"natural numbers":            testData{a: "file10", b: "file2", collation: Natural, response: 1},
+		"binary numbers":             testData{a: "file10", b: "file2", collation: Binary, response: -1},
+		"case insensitive":           testData{a: "Bob", b: "alice", collation: CaseInsensitive, response: 1},
+		"equal ignoring case":        testData{a: "Bob", b: "bob", collation: CaseInsensitive, response: -1},
+		"leading zeros":              testData{a: "1", b: "01", collation: Natural, response: 1},
+		"digits before letters":      testData{a: "a1", b: "ab", collation: Natural, response: -1},
+		"prefix goes first":          testData{a: "file", b: "file1", collation: Natural, response: -1},
+		"diacritics are ignored":     testData{a: "école", b: "ether", collation: Locale, response: -1},
+		"diacritics are not ignored": testData{a: "école", b: "ether", collation: CaseInsensitive, response: 1},
+	}
+
+	for k, v := range examples {
+		resp := Collate(v.a, v.b, v.collation)
+		if sign(resp) != v.response {
+			t.Errorf("test [%v] failed on method Collate with params(a: %v, b: %v), expected to be %v got %v", k, v.a, v.b, v.response, resp)
+		}
+	}
+}
+
+func sign(i int) int {
+	switch {
+	case i < 0:
+		return -1
+	case i > 0:
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,328 @@
+package arrays
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+
+	"github.com/maki5/rutils/arrays/lazy"
+)
+
+// OrderedArray generic array type for elements which can be ordered with < and >, it has all the methods of ComparableArray
+type OrderedArray[T cmp.Ordered] []T
+
+// Min return min element, nil is returned for empty array. NaN is returned only if it is the first element, use stats.Min to handle NaN explicitly
+func (arr *OrderedArray[T]) Min() *T {
+	if len(*arr) == 0 {
+		return nil
+	}
+
+	var min = (*arr)[0]
+
+	for _, el := range *arr {
+		if el < min {
+			min = el
+		}
+	}
+
+	return &min
+}
+
+// Max return max element, nil is returned for empty array. NaN is returned only if it is the first element, use stats.Max to handle NaN explicitly
+func (arr *OrderedArray[T]) Max() *T {
+	if len(*arr) == 0 {
+		return nil
+	}
+
+	var max = (*arr)[0]
+
+	for _, el := range *arr {
+		if el > max {
+			max = el
+		}
+	}
+
+	return &max
+}
+
+// Sort sorts array in place in ascending order, NaN values are placed before other numbers
+//
+// arr := OrderedArray[int]{3, 1, 2}
+//
+// arr.Sort() # => [1, 2, 3]
+func (arr *OrderedArray[T]) Sort() {
+	slices.Sort(*arr)
+}
+
+// SortDesc sorts array in place in descending order
+//
+// arr := OrderedArray[int]{3, 1, 2}
+//
+// arr.SortDesc() # => [3, 2, 1]
+func (arr *OrderedArray[T]) SortDesc() {
+	slices.SortFunc(*arr, descending[T])
+}
+
+// SortStable sorts array in place in ascending order, equal elements keep their original order
+func (arr *OrderedArray[T]) SortStable() {
+	slices.SortStableFunc(*arr, cmp.Compare[T])
+}
+
+// Sorted returns copy of array sorted in ascending order, array itself is not changed
+func (arr *OrderedArray[T]) Sorted() []T {
+	return arr.SortedFunc(cmp.Compare[T])
+}
+
+// SortedDesc returns copy of array sorted in descending order, array itself is not changed
+func (arr *OrderedArray[T]) SortedDesc() []T {
+	return arr.SortedFunc(descending[T])
+}
+
+// Delete deletes first occurrence of the element from array
+func (arr *OrderedArray[T]) Delete(elem T) {
+	(*ComparableArray[T])(arr).Delete(elem)
+}
+
+// Contains checks if array contains provided element
+func (arr *OrderedArray[T]) Contains(elem T) bool {
+	return (*ComparableArray[T])(arr).Contains(elem)
+}
+
+// Index return index of first matched element in array if not found return nil
+func (arr *OrderedArray[T]) Index(elem T) *int {
+	return (*ComparableArray[T])(arr).Index(elem)
+}
+
+// Uniq removes duplicated elements form given array, first occurrence of every element is kept so the order is preserved
+func (arr *OrderedArray[T]) Uniq() {
+	(*ComparableArray[T])(arr).Uniq()
+}
+
+// Tally returns number of occurrences of every element
+func (arr *OrderedArray[T]) Tally() map[T]int {
+	return (*ComparableArray[T])(arr).Tally()
+}
+
+// Clear remove all elements from array
+func (arr *OrderedArray[T]) Clear() {
+	(*ComparableArray[T])(arr).Clear()
+}
+
+// Collect return new array contained values returned by the provided function
+func (arr *OrderedArray[T]) Collect(exec func(el T) T) []T {
+	return (*ComparableArray[T])(arr).Collect(exec)
+}
+
+// Concat append elements of other arrays to self
+func (arr *OrderedArray[T]) Concat(arrays ...[]T) {
+	(*ComparableArray[T])(arr).Concat(arrays...)
+}
+
+// Map return new array contained values returned by the provided function
+func (arr *OrderedArray[T]) Map(exec func(el T) T) []T {
+	return (*ComparableArray[T])(arr).Map(exec)
+}
+
+// Pop removes n (1 by default) last elements from array and returns the last removed one, nil is returned if array has less than n elements
+func (arr *OrderedArray[T]) Pop(args ...int) *T {
+	return (*ComparableArray[T])(arr).Pop(args...)
+}
+
+// Push append element to array
+func (arr *OrderedArray[T]) Push(elem T) {
+	(*ComparableArray[T])(arr).Push(elem)
+}
+
+// Select returns a new array containing all elements of array for which the given block returns true
+func (arr *OrderedArray[T]) Select(exec func(elem T) bool) []T {
+	return (*ComparableArray[T])(arr).Select(exec)
+}
+
+// SortFunc sorts array in place using the given comparison function
+func (arr *OrderedArray[T]) SortFunc(cmp func(a, b T) int) {
+	(*ComparableArray[T])(arr).SortFunc(cmp)
+}
+
+// SortStableFunc sorts array in place using the given comparison function, equal elements keep their original order
+func (arr *OrderedArray[T]) SortStableFunc(cmp func(a, b T) int) {
+	(*ComparableArray[T])(arr).SortStableFunc(cmp)
+}
+
+// SortedFunc returns sorted copy of array, array itself is not changed
+func (arr *OrderedArray[T]) SortedFunc(cmp func(a, b T) int) []T {
+	return (*ComparableArray[T])(arr).SortedFunc(cmp)
+}
+
+// MinBy returns min element according to the given comparison function, nil is returned for empty array
+func (arr *OrderedArray[T]) MinBy(cmp func(a, b T) int) *T {
+	return (*ComparableArray[T])(arr).MinBy(cmp)
+}
+
+// MaxBy returns max element according to the given comparison function, nil is returned for empty array
+func (arr *OrderedArray[T]) MaxBy(cmp func(a, b T) int) *T {
+	return (*ComparableArray[T])(arr).MaxBy(cmp)
+}
+
+// Partition returns two arrays, the first contains elements for which the given function returns true and the second contains the rest
+func (arr *OrderedArray[T]) Partition(exec func(elem T) bool) ([]T, []T) {
+	return (*ComparableArray[T])(arr).Partition(exec)
+}
+
+// ChunkWhile splits array into runs of consecutive elements, array is split between elements for which the given function returns false
+func (arr *OrderedArray[T]) ChunkWhile(exec func(a, b T) bool) [][]T {
+	return (*ComparableArray[T])(arr).ChunkWhile(exec)
+}
+
+// SliceWhen splits array into runs of consecutive elements, array is split between elements for which the given function returns true
+func (arr *OrderedArray[T]) SliceWhen(exec func(a, b T) bool) [][]T {
+	return (*ComparableArray[T])(arr).SliceWhen(exec)
+}
+
+// EachSlice splits array into slices of n elements, the last slice may be shorter, nil is returned if n is not positive
+func (arr *OrderedArray[T]) EachSlice(n int) [][]T {
+	return (*ComparableArray[T])(arr).EachSlice(n)
+}
+
+// EachCons returns all windows of n consecutive elements, nil is returned if n is not positive
+func (arr *OrderedArray[T]) EachCons(n int) [][]T {
+	return (*ComparableArray[T])(arr).EachCons(n)
+}
+
+// Combination returns sequence of all combinations of k elements of array, combinations are generated lazily
+func (arr *OrderedArray[T]) Combination(k int) iter.Seq[[]T] {
+	return (*ComparableArray[T])(arr).Combination(k)
+}
+
+// Permutation returns sequence of all permutations of k elements of array, permutations are generated lazily
+func (arr *OrderedArray[T]) Permutation(k int) iter.Seq[[]T] {
+	return (*ComparableArray[T])(arr).Permutation(k)
+}
+
+// Union returns elements which are present in array or other array
+func (arr *OrderedArray[T]) Union(other []T) []T {
+	return (*ComparableArray[T])(arr).Union(other)
+}
+
+// Intersect returns elements which are present in both arrays
+func (arr *OrderedArray[T]) Intersect(other []T) []T {
+	return (*ComparableArray[T])(arr).Intersect(other)
+}
+
+// Difference returns elements of array which are not present in other array
+func (arr *OrderedArray[T]) Difference(other []T) []T {
+	return (*ComparableArray[T])(arr).Difference(other)
+}
+
+// SymmetricDifference returns elements which are present in only one of arrays, elements of array go first
+func (arr *OrderedArray[T]) SymmetricDifference(other []T) []T {
+	return (*ComparableArray[T])(arr).SymmetricDifference(other)
+}
+
+// IsSubsetOf checks if every element of array is present in other array
+func (arr *OrderedArray[T]) IsSubsetOf(other []T) bool {
+	return (*ComparableArray[T])(arr).IsSubsetOf(other)
+}
+
+// IsSupersetOf checks if every element of other array is present in array
+func (arr *OrderedArray[T]) IsSupersetOf(other []T) bool {
+	return (*ComparableArray[T])(arr).IsSupersetOf(other)
+}
+
+// Intersects checks if arrays have at least one common element
+func (arr *OrderedArray[T]) Intersects(other []T) bool {
+	return (*ComparableArray[T])(arr).Intersects(other)
+}
+
+// Disjoint checks if arrays have no common elements
+func (arr *OrderedArray[T]) Disjoint(other []T) bool {
+	return (*ComparableArray[T])(arr).Disjoint(other)
+}
+
+// BagUnion returns elements of both arrays, every element is kept as many times as it occurs in the array where it occurs most often
+func (arr *OrderedArray[T]) BagUnion(other []T) []T {
+	return (*ComparableArray[T])(arr).BagUnion(other)
+}
+
+// BagIntersect returns common elements of arrays, every element is kept as many times as it occurs in the array where it occurs less often
+func (arr *OrderedArray[T]) BagIntersect(other []T) []T {
+	return (*ComparableArray[T])(arr).BagIntersect(other)
+}
+
+// BagDifference returns elements of array with one occurrence removed for every occurrence in other array
+func (arr *OrderedArray[T]) BagDifference(other []T) []T {
+	return (*ComparableArray[T])(arr).BagDifference(other)
+}
+
+// BagSymmetricDifference returns BagDifference of array and other array followed by BagDifference of other array and array
+func (arr *OrderedArray[T]) BagSymmetricDifference(other []T) []T {
+	return (*ComparableArray[T])(arr).BagSymmetricDifference(other)
+}
+
+// IsSubBagOf checks if every element of array occurs in other array at least as many times as in array
+func (arr *OrderedArray[T]) IsSubBagOf(other []T) bool {
+	return (*ComparableArray[T])(arr).IsSubBagOf(other)
+}
+
+// IsSuperBagOf checks if every element of other array occurs in array at least as many times as in other array
+func (arr *OrderedArray[T]) IsSuperBagOf(other []T) bool {
+	return (*ComparableArray[T])(arr).IsSuperBagOf(other)
+}
+
+// Lazy returns lazy Enumerator over elements of array, chained methods don't allocate intermediate arrays
+func (arr *OrderedArray[T]) Lazy() *lazy.Enumerator[T] {
+	return (*ComparableArray[T])(arr).Lazy()
+}
+
+// Convert returns Convertible which converts elements of array using the given mode
+func (arr *OrderedArray[T]) Convert(mode ConversionMode) Convertible {
+	return (*ComparableArray[T])(arr).Convert(mode)
+}
+
+// ToStringArray implements Convertible for converting to string array
+func (arr *OrderedArray[T]) ToStringArray() (*[]string, error) {
+	return (*ComparableArray[T])(arr).ToStringArray()
+}
+
+// ToFloat64Array implements Convertible for converting to float64 array
+func (arr *OrderedArray[T]) ToFloat64Array() (*[]float64, error) {
+	return (*ComparableArray[T])(arr).ToFloat64Array()
+}
+
+// ToFloat32Array implements Convertible for converting to float32 array
+func (arr *OrderedArray[T]) ToFloat32Array() (*[]float32, error) {
+	return (*ComparableArray[T])(arr).ToFloat32Array()
+}
+
+// ToInt64Array implements Convertible for converting to int64 array
+func (arr *OrderedArray[T]) ToInt64Array() (*[]int64, error) {
+	return (*ComparableArray[T])(arr).ToInt64Array()
+}
+
+// ToInt32Array implements Convertible for converting to int32 array
+func (arr *OrderedArray[T]) ToInt32Array() (*[]int32, error) {
+	return (*ComparableArray[T])(arr).ToInt32Array()
+}
+
+// ToUintArray implements Convertible for converting to uint array
+func (arr *OrderedArray[T]) ToUintArray() (*[]uint, error) {
+	return (*ComparableArray[T])(arr).ToUintArray()
+}
+
+// ToUint32Array implements Convertible for converting to uint32 array
+func (arr *OrderedArray[T]) ToUint32Array() (*[]uint32, error) {
+	return (*ComparableArray[T])(arr).ToUint32Array()
+}
+
+// ToUint64Array implements Convertible for converting to uint64 array
+func (arr *OrderedArray[T]) ToUint64Array() (*[]uint64, error) {
+	return (*ComparableArray[T])(arr).ToUint64Array()
+}
+
+// ToJSON implements Convertible for converting to json string
+func (arr *OrderedArray[T]) ToJSON() (string, error) {
+	return (*ComparableArray[T])(arr).ToJSON()
+}
+
+func descending[T cmp.Ordered](a, b T) int {
+	return cmp.Compare(b, a)
+}
@@ -0,0 +1,96 @@
+package arrays
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestOrderedArrayMinMax(t *testing.T) {
+	type testData struct {
+		arr []uint32
+		min *uint32
+		max *uint32
+	}
+
+	one, five, seven := uint32(1), uint32(5), uint32(7)
+
+	examples := map[string]testData{
+		"empty arr":         testData{arr: []uint32{}, min: nil, max: nil},
+		"one element":       testData{arr: []uint32{5}, min: &five, max: &five},
+		"multiple elements": testData{arr: []uint32{5, 1, 7, 5}, min: &one, max: &seven},
+	}
+
+	for k, v := range examples {
+		initialArr := OrderedArray[uint32](v.arr)
+
+		resp := initialArr.Min()
+		if (resp == nil) != (v.min == nil) || (resp != nil && *resp != *v.min) {
+			t.Errorf("test [%v] failed on method Min with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.min, resp)
+		}
+
+		resp = initialArr.Max()
+		if (resp == nil) != (v.max == nil) || (resp != nil && *resp != *v.max) {
+			t.Errorf("test [%v] failed on method Max with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.max, resp)
+		}
+	}
+}
+
+func TestOrderedArrayStrings(t *testing.T) {
+	initialArr := OrderedArray[string]{"b", "c", "a"}
+
+	if min := initialArr.Min(); min == nil || *min != "a" {
+		t.Errorf("test [strings] failed on method Min, expected to be %v got %v", "a", min)
+	}
+
+	if max := initialArr.Max(); max == nil || *max != "c" {
+		t.Errorf("test [strings] failed on method Max, expected to be %v got %v", "c", max)
+	}
+
+	initialArr.Delete("c")
+	if initialArr.Contains("c") || len(initialArr) != 2 {
+		t.Errorf("test [strings] failed on method Delete, expected %v to be deleted got %v", "c", initialArr)
+	}
+}
+
+func TestOrderedArraySort(t *testing.T) {
+	type testData struct {
+		arr      []float64
+		asc      []float64
+		desc     []float64
+		response []float64
+	}
+
+	examples := map[string]testData{
+		"empty arr":         testData{arr: []float64{}, asc: []float64{}, desc: []float64{}},
+		"one element":       testData{arr: []float64{1.5}, asc: []float64{1.5}, desc: []float64{1.5}},
+		"multiple elements": testData{arr: []float64{3, -1.5, 2, 3}, asc: []float64{-1.5, 2, 3, 3}, desc: []float64{3, 3, 2, -1.5}},
+	}
+
+	for k, v := range examples {
+		initialArr := OrderedArray[float64](slices.Clone(v.arr))
+
+		if resp := initialArr.Sorted(); !reflect.DeepEqual(resp, v.asc) || !reflect.DeepEqual([]float64(initialArr), v.arr) {
+			t.Errorf("test [%v] failed on method Sorted with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.asc, resp)
+		}
+
+		if resp := initialArr.SortedDesc(); !reflect.DeepEqual(resp, v.desc) {
+			t.Errorf("test [%v] failed on method SortedDesc with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.desc, resp)
+		}
+
+		initialArr.Sort()
+		if !reflect.DeepEqual([]float64(initialArr), v.asc) {
+			t.Errorf("test [%v] failed on method Sort with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.asc, initialArr)
+		}
+
+		initialArr.SortDesc()
+		if !reflect.DeepEqual([]float64(initialArr), v.desc) {
+			t.Errorf("test [%v] failed on method SortDesc with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.desc, initialArr)
+		}
+
+		initialArr.SortStable()
+		if !reflect.DeepEqual([]float64(initialArr), v.asc) {
+			t.Errorf("test [%v] failed on method SortStable with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.asc, initialArr)
+		}
+	}
+}
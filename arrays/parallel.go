@@ -0,0 +1,119 @@
+package arrays
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Parallel functions call the given function for elements of array from at most workers goroutines
+// (runtime.GOMAXPROCS by default, when workers is not positive). The first error cancels the context passed to the function,
+// elements which haven't been started yet are skipped and the error is returned. If the parent context is canceled before all elements
+// are processed its cause is returned
+
+// ParallelMap returns values returned by the provided function in the order of elements of array
+//
+// ParallelMap(ctx, urls, 8, func(ctx context.Context, url string) (int, error) { return fetchStatus(ctx, url) })
+func ParallelMap[S ~[]T, T any, R any](ctx context.Context, arr S, workers int, exec func(ctx context.Context, el T) (R, error)) ([]R, error) {
+	resArr := make([]R, len(arr))
+
+	err := parallel(ctx, len(arr), workers, func(ctx context.Context, i int) error {
+		r, err := exec(ctx, arr[i])
+		if err != nil {
+			return err
+		}
+
+		resArr[i] = r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resArr, nil
+}
+
+// ParallelEach calls the provided function for every element of array
+func ParallelEach[S ~[]T, T any](ctx context.Context, arr S, workers int, exec func(ctx context.Context, el T) error) error {
+	return parallel(ctx, len(arr), workers, func(ctx context.Context, i int) error {
+		return exec(ctx, arr[i])
+	})
+}
+
+// ParallelSelect returns elements of array for which the provided function returns true, the order of elements is kept
+func ParallelSelect[S ~[]T, T any](ctx context.Context, arr S, workers int, exec func(ctx context.Context, el T) (bool, error)) (S, error) {
+	selected, err := ParallelMap(ctx, arr, workers, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	resArr := make(S, 0)
+	for i, ok := range selected {
+		if ok {
+			resArr = append(resArr, arr[i])
+		}
+	}
+
+	return resArr, nil
+}
+
+// parallel calls exec for indexes from 0 to n-1 from a bounded number of goroutines and returns the first error
+func parallel(ctx context.Context, n int, workers int, exec func(ctx context.Context, i int) error) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	var (
+		wg        sync.WaitGroup
+		once      sync.Once
+		firstErr  error
+		processed atomic.Int64
+	)
+
+	indexes := make(chan int)
+	for w := 0; w < min(workers, n); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				if ctx.Err() != nil {
+					continue
+				}
+
+				if err := exec(ctx, i); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel(err)
+					})
+				}
+				processed.Add(1)
+			}
+		}()
+	}
+
+feed:
+	for i := 0; i < n; i++ {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	if processed.Load() == int64(n) {
+		return nil
+	}
+
+	return context.Cause(ctx)
+}
@@ -0,0 +1,140 @@
+package arrays
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelMap(t *testing.T) {
+	type testData struct {
+		arr      []string
+		workers  int
+		response []string
+	}
+
+	examples := map[string]testData{
+		"empty arr":       testData{arr: []string{}, workers: 2, response: []string{}},
+		"one worker":      testData{arr: []string{"a", "b", "c"}, workers: 1, response: []string{"A", "B", "C"}},
+		"many workers":    testData{arr: []string{"a", "b", "c", "d", "e"}, workers: 3, response: []string{"A", "B", "C", "D", "E"}},
+		"default workers": testData{arr: []string{"a", "b"}, workers: 0, response: []string{"A", "B"}},
+	}
+
+	for k, v := range examples {
+		initialArr := Array[string](v.arr)
+
+		resp, err := ParallelMap(context.Background(), initialArr, v.workers, func(ctx context.Context, el string) (string, error) {
+			// later elements finish first, so the order of results doesn't depend on the order of completion
+			time.Sleep(time.Duration(len(v.arr)-int(el[0]-'a')) * time.Millisecond)
+			return strings.ToUpper(el), nil
+		})
+
+		if err != nil || !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed on method ParallelMap with params(initialArr: %v), expected to be %v got %v and error %v", k, v.arr, v.response, resp, err)
+		}
+	}
+}
+
+func TestParallelWorkersLimit(t *testing.T) {
+	var running, maxRunning int32
+
+	arr := make([]int, 20)
+	err := ParallelEach(context.Background(), arr, 3, func(ctx context.Context, el int) error {
+		r := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if r <= m || atomic.CompareAndSwapInt32(&maxRunning, m, r) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil
+	})
+
+	if err != nil || maxRunning > 3 || maxRunning == 0 {
+		t.Errorf("test [workers limit] failed on method ParallelEach, expected at most 3 running workers got %v and error %v", maxRunning, err)
+	}
+}
+
+func TestParallelFirstError(t *testing.T) {
+	errBroken := errors.New("broken")
+	var processed int32
+
+	arr := make([]int, 100)
+	for i := range arr {
+		arr[i] = i
+	}
+
+	resp, err := ParallelMap(context.Background(), arr, 2, func(ctx context.Context, el int) (int, error) {
+		atomic.AddInt32(&processed, 1)
+		if el == 3 {
+			return 0, errBroken
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(time.Millisecond):
+		}
+
+		return el, nil
+	})
+
+	if resp != nil || err != errBroken {
+		t.Errorf("test [first error] failed on method ParallelMap, expected error to be %v got %v and %v", errBroken, err, resp)
+	}
+
+	if processed >= int32(len(arr)) {
+		t.Errorf("test [first error] failed on method ParallelMap, expected remaining elements to be skipped got %v processed", processed)
+	}
+}
+
+func TestParallelCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var processed int32
+	err := ParallelEach(ctx, []int{1, 2, 3}, 2, func(ctx context.Context, el int) error {
+		atomic.AddInt32(&processed, 1)
+		return nil
+	})
+
+	if !errors.Is(err, context.Canceled) || processed != 0 {
+		t.Errorf("test [canceled context] failed on method ParallelEach, expected error to be %v got %v and %v processed elements", context.Canceled, err, processed)
+	}
+}
+
+func TestParallelCanceledAfterLastElement(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp, err := ParallelMap(ctx, []int{1, 2, 3}, 1, func(ctx context.Context, el int) (int, error) {
+		if el == 3 {
+			cancel()
+		}
+		return el * 2, nil
+	})
+
+	if err != nil || !reflect.DeepEqual(resp, []int{2, 4, 6}) {
+		t.Errorf("test [canceled after last element] failed on method ParallelMap, expected to be [2 4 6] got %v and error %v", resp, err)
+	}
+}
+
+func TestParallelSelect(t *testing.T) {
+	initialArr := ComparableArray[int]{5, 2, 8, 1, 6}
+
+	resp, err := ParallelSelect(context.Background(), initialArr, 2, func(ctx context.Context, el int) (bool, error) {
+		return el%2 == 0, nil
+	})
+
+	response := ComparableArray[int]{2, 8, 6}
+	if err != nil || !reflect.DeepEqual(resp, response) {
+		t.Errorf("test [even numbers] failed on method ParallelSelect with params(initialArr: %v), expected to be %v got %v and error %v", initialArr, response, resp, err)
+	}
+}
@@ -0,0 +1,228 @@
+package arrays
+
+// Set operations treat arrays as sets: duplicates are removed from the result and the order of first occurrence is kept.
+// Bag operations treat arrays as multisets, so every element is kept as many times as its number of occurrences allows
+
+// Union returns elements which are present in array or other array
+//
+// arr := ComparableArray[int]{1, 2, 2, 3}
+//
+// arr.Union([]int{3, 4}) # => [1, 2, 3, 4]
+func (arr *ComparableArray[T]) Union(other []T) []T {
+	union := make([]T, 0, len(*arr)+len(other))
+	union = append(union, *arr...)
+	union = append(union, other...)
+
+	UniqBy(&union, func(el T) T { return el })
+
+	return union
+}
+
+// Intersect returns elements which are present in both arrays
+//
+// arr := ComparableArray[int]{1, 2, 2, 3}
+//
+// arr.Intersect([]int{2, 3, 4}) # => [2, 3]
+func (arr *ComparableArray[T]) Intersect(other []T) []T {
+	otherSet := toSet(other)
+
+	return uniqSelect(*arr, func(el T) bool {
+		_, ok := otherSet[el]
+		return ok
+	})
+}
+
+// Difference returns elements of array which are not present in other array
+//
+// arr := ComparableArray[int]{1, 2, 2, 3}
+//
+// arr.Difference([]int{3, 4}) # => [1, 2]
+func (arr *ComparableArray[T]) Difference(other []T) []T {
+	otherSet := toSet(other)
+
+	return uniqSelect(*arr, func(el T) bool {
+		_, ok := otherSet[el]
+		return !ok
+	})
+}
+
+// SymmetricDifference returns elements which are present in only one of arrays, elements of array go first
+//
+// arr := ComparableArray[int]{1, 2, 3}
+//
+// arr.SymmetricDifference([]int{3, 4}) # => [1, 2, 4]
+func (arr *ComparableArray[T]) SymmetricDifference(other []T) []T {
+	otherArr := ComparableArray[T](other)
+
+	return append(arr.Difference(other), otherArr.Difference(*arr)...)
+}
+
+// IsSubsetOf checks if every element of array is present in other array
+//
+// arr := ComparableArray[int]{1, 1, 2}
+//
+// arr.IsSubsetOf([]int{1, 2, 3}) # => true
+func (arr *ComparableArray[T]) IsSubsetOf(other []T) bool {
+	otherSet := toSet(other)
+
+	for _, el := range *arr {
+		if _, ok := otherSet[el]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSupersetOf checks if every element of other array is present in array
+func (arr *ComparableArray[T]) IsSupersetOf(other []T) bool {
+	otherArr := ComparableArray[T](other)
+
+	return otherArr.IsSubsetOf(*arr)
+}
+
+// Intersects checks if arrays have at least one common element
+//
+// arr := ComparableArray[int]{1, 2}
+//
+// arr.Intersects([]int{2, 3}) # => true
+func (arr *ComparableArray[T]) Intersects(other []T) bool {
+	set := toSet(*arr)
+
+	for _, el := range other {
+		if _, ok := set[el]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Disjoint checks if arrays have no common elements
+func (arr *ComparableArray[T]) Disjoint(other []T) bool {
+	return !arr.Intersects(other)
+}
+
+// BagUnion returns elements of both arrays, every element is kept as many times as it occurs in the array where it occurs most often
+//
+// arr := ComparableArray[int]{1, 1, 2}
+//
+// arr.BagUnion([]int{1, 2, 2, 3}) # => [1, 1, 2, 2, 3]
+func (arr *ComparableArray[T]) BagUnion(other []T) []T {
+	counts := arr.Tally()
+
+	union := make([]T, 0, len(*arr)+len(other))
+	union = append(union, *arr...)
+
+	seen := make(map[T]int, len(other))
+	for _, el := range other {
+		seen[el]++
+		if seen[el] > counts[el] {
+			union = append(union, el)
+		}
+	}
+
+	return union
+}
+
+// BagIntersect returns common elements of arrays, every element is kept as many times as it occurs in the array where it occurs less often
+//
+// arr := ComparableArray[int]{1, 1, 2, 3}
+//
+// arr.BagIntersect([]int{1, 1, 1, 3}) # => [1, 1, 3]
+func (arr *ComparableArray[T]) BagIntersect(other []T) []T {
+	counts := CountBy(other, func(el T) T { return el })
+
+	intersection := make([]T, 0)
+	for _, el := range *arr {
+		if counts[el] > 0 {
+			counts[el]--
+			intersection = append(intersection, el)
+		}
+	}
+
+	return intersection
+}
+
+// BagDifference returns elements of array with one occurrence removed for every occurrence in other array,
+// the first occurrences are removed
+//
+// arr := ComparableArray[int]{1, 2, 1, 3}
+//
+// arr.BagDifference([]int{1, 3}) # => [2, 1]
+func (arr *ComparableArray[T]) BagDifference(other []T) []T {
+	counts := CountBy(other, func(el T) T { return el })
+
+	difference := make([]T, 0)
+	for _, el := range *arr {
+		if counts[el] > 0 {
+			counts[el]--
+			continue
+		}
+		difference = append(difference, el)
+	}
+
+	return difference
+}
+
+// BagSymmetricDifference returns BagDifference of array and other array followed by BagDifference of other array and array
+//
+// arr := ComparableArray[int]{1, 1, 2}
+//
+// arr.BagSymmetricDifference([]int{1, 3}) # => [1, 2, 3]
+func (arr *ComparableArray[T]) BagSymmetricDifference(other []T) []T {
+	otherArr := ComparableArray[T](other)
+
+	return append(arr.BagDifference(other), otherArr.BagDifference(*arr)...)
+}
+
+// IsSubBagOf checks if every element of array occurs in other array at least as many times as in array
+//
+// arr := ComparableArray[int]{1, 1, 2}
+//
+// arr.IsSubBagOf([]int{1, 2, 3}) # => false
+func (arr *ComparableArray[T]) IsSubBagOf(other []T) bool {
+	counts := CountBy(other, func(el T) T { return el })
+
+	for _, el := range *arr {
+		counts[el]--
+		if counts[el] < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperBagOf checks if every element of other array occurs in array at least as many times as in other array
+func (arr *ComparableArray[T]) IsSuperBagOf(other []T) bool {
+	otherArr := ComparableArray[T](other)
+
+	return otherArr.IsSubBagOf(*arr)
+}
+
+func toSet[T comparable](arr []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(arr))
+	for _, el := range arr {
+		set[el] = struct{}{}
+	}
+
+	return set
+}
+
+// uniqSelect returns elements for which the given function returns true, duplicates are removed
+func uniqSelect[T comparable](arr []T, exec func(el T) bool) []T {
+	seen := make(map[T]struct{})
+	resArr := make([]T, 0)
+
+	for _, el := range arr {
+		if _, ok := seen[el]; ok || !exec(el) {
+			continue
+		}
+
+		seen[el] = struct{}{}
+		resArr = append(resArr, el)
+	}
+
+	return resArr
+}
@@ -0,0 +1,140 @@
+package arrays
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSetOperations(t *testing.T) {
+	type testData struct {
+		arr                 []int
+		other               []int
+		union               []int
+		intersect           []int
+		difference          []int
+		symmetricDifference []int
+	}
+
+	examples := map[string]testData{
+		"empty arrays": testData{arr: []int{}, other: []int{}, union: []int{}, intersect: []int{}, difference: []int{}, symmetricDifference: []int{}},
+		"empty other":  testData{arr: []int{2, 1, 2}, other: []int{}, union: []int{2, 1}, intersect: []int{}, difference: []int{2, 1}, symmetricDifference: []int{2, 1}},
+		"common elements": testData{arr: []int{3, 1, 2, 2}, other: []int{4, 2, 3, 4}, union: []int{3, 1, 2, 4}, intersect: []int{3, 2},
+			difference: []int{1}, symmetricDifference: []int{1, 4}},
+		"no common elements": testData{arr: []int{1, 2}, other: []int{3}, union: []int{1, 2, 3}, intersect: []int{}, difference: []int{1, 2},
+			symmetricDifference: []int{1, 2, 3}},
+	}
+
+	for k, v := range examples {
+		initialArr := ComparableArray[int](v.arr)
+
+		if resp := initialArr.Union(v.other); !reflect.DeepEqual(resp, v.union) {
+			t.Errorf("test [%v] failed on method Union with params(initialArr: %v, other: %v), expected to be %v got %v", k, v.arr, v.other, v.union, resp)
+		}
+
+		if resp := initialArr.Intersect(v.other); !reflect.DeepEqual(resp, v.intersect) {
+			t.Errorf("test [%v] failed on method Intersect with params(initialArr: %v, other: %v), expected to be %v got %v", k, v.arr, v.other, v.intersect, resp)
+		}
+
+		if resp := initialArr.Difference(v.other); !reflect.DeepEqual(resp, v.difference) {
+			t.Errorf("test [%v] failed on method Difference with params(initialArr: %v, other: %v), expected to be %v got %v", k, v.arr, v.other, v.difference, resp)
+		}
+
+		if resp := initialArr.SymmetricDifference(v.other); !reflect.DeepEqual(resp, v.symmetricDifference) {
+			t.Errorf("test [%v] failed on method SymmetricDifference with params(initialArr: %v, other: %v), expected to be %v got %v",
+				k, v.arr, v.other, v.symmetricDifference, resp)
+		}
+	}
+}
+
+func TestSetPredicates(t *testing.T) {
+	type testData struct {
+		arr        []string
+		other      []string
+		subset     bool
+		superset   bool
+		intersects bool
+	}
+
+	examples := map[string]testData{
+		"empty arrays":   testData{arr: []string{}, other: []string{}, subset: true, superset: true, intersects: false},
+		"subset":         testData{arr: []string{"a", "a"}, other: []string{"b", "a"}, subset: true, superset: false, intersects: true},
+		"superset":       testData{arr: []string{"a", "b", "c"}, other: []string{"c", "c"}, subset: false, superset: true, intersects: true},
+		"equal sets":     testData{arr: []string{"a", "b"}, other: []string{"b", "a", "b"}, subset: true, superset: true, intersects: true},
+		"overlapping":    testData{arr: []string{"a", "b"}, other: []string{"b", "c"}, subset: false, superset: false, intersects: true},
+		"disjoint":       testData{arr: []string{"a"}, other: []string{"b"}, subset: false, superset: false, intersects: false},
+		"empty is below": testData{arr: []string{}, other: []string{"b"}, subset: true, superset: false, intersects: false},
+	}
+
+	for k, v := range examples {
+		initialArr := ComparableArray[string](v.arr)
+
+		if resp := initialArr.IsSubsetOf(v.other); resp != v.subset {
+			t.Errorf("test [%v] failed on method IsSubsetOf with params(initialArr: %v, other: %v), expected to be %v got %v", k, v.arr, v.other, v.subset, resp)
+		}
+
+		if resp := initialArr.IsSupersetOf(v.other); resp != v.superset {
+			t.Errorf("test [%v] failed on method IsSupersetOf with params(initialArr: %v, other: %v), expected to be %v got %v", k, v.arr, v.other, v.superset, resp)
+		}
+
+		if resp := initialArr.Intersects(v.other); resp != v.intersects {
+			t.Errorf("test [%v] failed on method Intersects with params(initialArr: %v, other: %v), expected to be %v got %v", k, v.arr, v.other, v.intersects, resp)
+		}
+
+		if resp := initialArr.Disjoint(v.other); resp == v.intersects {
+			t.Errorf("test [%v] failed on method Disjoint with params(initialArr: %v, other: %v), expected to be %v got %v", k, v.arr, v.other, !v.intersects, resp)
+		}
+	}
+}
+
+func TestBagOperations(t *testing.T) {
+	type testData struct {
+		arr                 []int
+		other               []int
+		union               []int
+		intersect           []int
+		difference          []int
+		symmetricDifference []int
+		subBag              bool
+		superBag            bool
+	}
+
+	examples := map[string]testData{
+		"empty arrays": testData{arr: []int{}, other: []int{}, union: []int{}, intersect: []int{}, difference: []int{}, symmetricDifference: []int{},
+			subBag: true, superBag: true},
+		"duplicates": testData{arr: []int{1, 2, 1, 3}, other: []int{1, 3, 3, 4}, union: []int{1, 2, 1, 3, 3, 4}, intersect: []int{1, 3},
+			difference: []int{2, 1}, symmetricDifference: []int{2, 1, 3, 4}, subBag: false, superBag: false},
+		"sub bag": testData{arr: []int{1, 1}, other: []int{1, 2, 1, 1}, union: []int{1, 1, 2, 1}, intersect: []int{1, 1},
+			difference: []int{}, symmetricDifference: []int{2, 1}, subBag: true, superBag: false},
+		"same elements": testData{arr: []int{1, 1, 2}, other: []int{1, 2}, union: []int{1, 1, 2}, intersect: []int{1, 2},
+			difference: []int{1}, symmetricDifference: []int{1}, subBag: false, superBag: true},
+	}
+
+	for k, v := range examples {
+		initialArr := ComparableArray[int](v.arr)
+
+		if resp := initialArr.BagUnion(v.other); !reflect.DeepEqual(resp, v.union) {
+			t.Errorf("test [%v] failed on method BagUnion with params(initialArr: %v, other: %v), expected to be %v got %v", k, v.arr, v.other, v.union, resp)
+		}
+
+		if resp := initialArr.BagIntersect(v.other); !reflect.DeepEqual(resp, v.intersect) {
+			t.Errorf("test [%v] failed on method BagIntersect with params(initialArr: %v, other: %v), expected to be %v got %v", k, v.arr, v.other, v.intersect, resp)
+		}
+
+		if resp := initialArr.BagDifference(v.other); !reflect.DeepEqual(resp, v.difference) {
+			t.Errorf("test [%v] failed on method BagDifference with params(initialArr: %v, other: %v), expected to be %v got %v", k, v.arr, v.other, v.difference, resp)
+		}
+
+		if resp := initialArr.BagSymmetricDifference(v.other); !reflect.DeepEqual(resp, v.symmetricDifference) {
+			t.Errorf("test [%v] failed on method BagSymmetricDifference with params(initialArr: %v, other: %v), expected to be %v got %v",
+				k, v.arr, v.other, v.symmetricDifference, resp)
+		}
+
+		if resp := initialArr.IsSubBagOf(v.other); resp != v.subBag {
+			t.Errorf("test [%v] failed on method IsSubBagOf with params(initialArr: %v, other: %v), expected to be %v got %v", k, v.arr, v.other, v.subBag, resp)
+		}
+
+		if resp := initialArr.IsSuperBagOf(v.other); resp != v.superBag {
+			t.Errorf("test [%v] failed on method IsSuperBagOf with params(initialArr: %v, other: %v), expected to be %v got %v", k, v.arr, v.other, v.superBag, resp)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package stats
+
+import "math"
+
+// Bin is a range of histogram with number of values which fall into it, Max is excluded from the range except for the last bin
+type Bin struct {
+	Min   float64
+	Max   float64
+	Count int
+}
+
+// Histogram splits range between min and max value into the given number of bins of equal width and counts values in every bin.
+// If all values are equal the range is extended by 0.5 in both directions
+//
+// Histogram([]int{1, 2, 2, 3, 5}, 2) # => [{Min: 1, Max: 3, Count: 3}, {Min: 3, Max: 5, Count: 2}]
+func Histogram[S ~[]T, T Number](arr S, bins int, opts ...Options) ([]Bin, error) {
+	if bins < 1 {
+		return nil, ErrOutOfRange
+	}
+
+	values, nan := toFloats(arr, options(opts))
+	switch {
+	case nan:
+		return nil, ErrNotFinite
+	case len(values) == 0:
+		return nil, ErrEmpty
+	}
+
+	min, max := values[0], values[0]
+	for _, v := range values {
+		min = math.Min(min, v)
+		max = math.Max(max, v)
+	}
+
+	if math.IsInf(min, 0) || math.IsInf(max, 0) {
+		return nil, ErrNotFinite
+	}
+
+	if min == max {
+		min, max = min-0.5, max+0.5
+	}
+
+	// bounds are scaled before subtraction, so the width of range wider than math.MaxFloat64 doesn't overflow
+	width := max/float64(bins) - min/float64(bins)
+	if width == 0 {
+		return nil, ErrOutOfRange
+	}
+
+	histogram := make([]Bin, 0, bins)
+	for i := 0; i < bins; i++ {
+		histogram = append(histogram, Bin{Min: min + float64(i)*width, Max: min + float64(i+1)*width})
+	}
+	histogram[bins-1].Max = max
+
+	for _, v := range values {
+		i := int(v/width - min/width)
+		if i < 0 {
+			i = 0
+		} else if i >= bins {
+			i = bins - 1
+		}
+		histogram[i].Count++
+	}
+
+	return histogram, nil
+}
@@ -0,0 +1,112 @@
+package stats
+
+import (
+	"math"
+	"slices"
+)
+
+// Interpolation defines how percentile is calculated when it falls between two values
+type Interpolation int
+
+const (
+	// Linear interpolates between two values proportionally to the position of percentile
+	Linear Interpolation = iota
+	// Lower takes the lower value
+	Lower
+	// Higher takes the higher value
+	Higher
+	// Nearest takes the nearest value, the value at the even index of sorted values if percentile is in the middle of two
+	Nearest
+	// Midpoint takes the mean of two values
+	Midpoint
+)
+
+// Percentile returns value below which p percent of values fall, p must be between 0 and 100
+//
+// Percentile([]int{1, 2, 3, 4}, 50)                                  # => 2.5
+//
+// Percentile([]int{1, 2, 3, 4}, 50, Options{Interpolation: Lower})   # => 2
+func Percentile[S ~[]T, T Number](arr S, p float64, opts ...Options) (float64, error) {
+	percentiles, err := percentiles(arr, []float64{p}, options(opts))
+	if err != nil {
+		return 0, err
+	}
+
+	return percentiles[0], nil
+}
+
+// Median returns the middle value, mean of two middle values is returned for even number of values by default
+//
+// Median([]int{3, 1, 2}) # => 2
+func Median[S ~[]T, T Number](arr S, opts ...Options) (float64, error) {
+	return Percentile(arr, 50, opts...)
+}
+
+// Quantiles returns n-1 cut points which divide values into n groups of equal size, n must be at least 1
+//
+// Quantiles([]int{1, 2, 3, 4, 5}, 4) # => [2, 3, 4]
+func Quantiles[S ~[]T, T Number](arr S, n int, opts ...Options) ([]float64, error) {
+	if n < 1 {
+		return nil, ErrOutOfRange
+	}
+
+	ps := make([]float64, 0, n-1)
+	for i := 1; i < n; i++ {
+		ps = append(ps, 100*float64(i)/float64(n))
+	}
+
+	return percentiles(arr, ps, options(opts))
+}
+
+// percentiles sorts values once and returns all the requested percentiles
+func percentiles[S ~[]T, T Number](arr S, ps []float64, o Options) ([]float64, error) {
+	for _, p := range ps {
+		if p < 0 || p > 100 || math.IsNaN(p) {
+			return nil, ErrOutOfRange
+		}
+	}
+
+	values, nan := toFloats(arr, o)
+	if nan {
+		result := make([]float64, len(ps))
+		for i := range result {
+			result[i] = math.NaN()
+		}
+		return result, nil
+	}
+
+	if len(values) == 0 {
+		return nil, ErrEmpty
+	}
+
+	slices.Sort(values)
+
+	result := make([]float64, 0, len(ps))
+	for _, p := range ps {
+		result = append(result, percentileOfSorted(values, p, o.Interpolation))
+	}
+
+	return result, nil
+}
+
+func percentileOfSorted(values []float64, p float64, interpolation Interpolation) float64 {
+	rank := p / 100 * float64(len(values)-1)
+	lower, higher := int(math.Floor(rank)), int(math.Ceil(rank))
+
+	if lower == higher {
+		return values[lower]
+	}
+
+	switch interpolation {
+	case Lower:
+		return values[lower]
+	case Higher:
+		return values[higher]
+	case Nearest:
+		return values[int(math.RoundToEven(rank))]
+	case Midpoint:
+		return (values[lower] + values[higher]) / 2
+	}
+
+	return values[lower] + (rank-float64(lower))*(values[higher]-values[lower])
+}
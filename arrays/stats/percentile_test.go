@@ -0,0 +1,108 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPercentile(t *testing.T) {
+	type testData struct {
+		arr           []int
+		p             float64
+		interpolation Interpolation
+		response      float64
+		err           error
+	}
+
+	examples := map[string]testData{
+		"empty arr":          testData{arr: []int{}, p: 50, err: ErrEmpty},
+		"negative p":         testData{arr: []int{1}, p: -1, err: ErrOutOfRange},
+		"p above 100":        testData{arr: []int{1}, p: 101, err: ErrOutOfRange},
+		"one element":        testData{arr: []int{7}, p: 90, response: 7},
+		"min":                testData{arr: []int{4, 1, 3, 2}, p: 0, response: 1},
+		"max":                testData{arr: []int{4, 1, 3, 2}, p: 100, response: 4},
+		"exact rank":         testData{arr: []int{5, 1, 3}, p: 50, response: 3},
+		"linear":             testData{arr: []int{4, 1, 3, 2}, p: 50, interpolation: Linear, response: 2.5},
+		"linear uneven":      testData{arr: []int{10, 20, 30, 40}, p: 90, interpolation: Linear, response: 37},
+		"lower":              testData{arr: []int{4, 1, 3, 2}, p: 50, interpolation: Lower, response: 2},
+		"higher":             testData{arr: []int{4, 1, 3, 2}, p: 50, interpolation: Higher, response: 3},
+		"nearest":            testData{arr: []int{10, 20, 30, 40}, p: 90, interpolation: Nearest, response: 40},
+		"nearest tie":        testData{arr: []int{4, 1, 3, 2}, p: 50, interpolation: Nearest, response: 3},
+		"nearest even index": testData{arr: []int{3, 1, 2}, p: 25, interpolation: Nearest, response: 1},
+		"midpoint":           testData{arr: []int{10, 20, 30, 40}, p: 90, interpolation: Midpoint, response: 35},
+		"midpoint exact":     testData{arr: []int{10, 20, 30}, p: 50, interpolation: Midpoint, response: 20},
+		"unsorted duplicate": testData{arr: []int{2, 2, 9, 1}, p: 25, response: 1.75},
+	}
+
+	for k, v := range examples {
+		resp, err := Percentile(v.arr, v.p, Options{Interpolation: v.interpolation})
+
+		if err != v.err || !sameFloat(resp, v.response) {
+			t.Errorf("test [%v] failed on method Percentile with params(arr: %v, p: %v), expected to be %v got %v and error to be %v got %v",
+				k, v.arr, v.p, v.response, resp, v.err, err)
+		}
+	}
+}
+
+func TestMedian(t *testing.T) {
+	type testData struct {
+		arr      []float64
+		opts     []Options
+		response float64
+		err      error
+	}
+
+	examples := map[string]testData{
+		"empty arr":     testData{arr: []float64{}, err: ErrEmpty},
+		"odd length":    testData{arr: []float64{3, 1, 2}, response: 2},
+		"even length":   testData{arr: []float64{4, 1, 3, 2}, response: 2.5},
+		"skip NaN":      testData{arr: []float64{nan, 3, 1}, opts: []Options{{NaN: SkipNaN}}, response: 2},
+		"propagate NaN": testData{arr: []float64{nan, 3, 1}, response: nan},
+	}
+
+	for k, v := range examples {
+		resp, err := Median(v.arr, v.opts...)
+
+		if err != v.err || !sameFloat(resp, v.response) {
+			t.Errorf("test [%v] failed on method Median with params(arr: %v), expected to be %v got %v and error to be %v got %v",
+				k, v.arr, v.response, resp, v.err, err)
+		}
+	}
+}
+
+func TestQuantiles(t *testing.T) {
+	type testData struct {
+		arr      []int
+		n        int
+		response []float64
+		err      error
+	}
+
+	examples := map[string]testData{
+		"invalid n": testData{arr: []int{1, 2}, n: 0, err: ErrOutOfRange},
+		"one group": testData{arr: []int{1, 2}, n: 1, response: []float64{}},
+		"quartiles": testData{arr: []int{5, 4, 3, 2, 1}, n: 4, response: []float64{2, 3, 4}},
+		"deciles":   testData{arr: []int{0, 100}, n: 10, response: []float64{10, 20, 30, 40, 50, 60, 70, 80, 90}},
+	}
+
+	for k, v := range examples {
+		resp, err := Quantiles(v.arr, v.n)
+
+		if err != v.err || len(resp) != len(v.response) {
+			t.Errorf("test [%v] failed on method Quantiles with params(arr: %v, n: %v), expected to be %v got %v and error to be %v got %v",
+				k, v.arr, v.n, v.response, resp, v.err, err)
+			continue
+		}
+
+		for i := range resp {
+			if !sameFloat(resp[i], v.response[i]) {
+				t.Errorf("test [%v] failed on method Quantiles with params(arr: %v, n: %v), expected to be %v got %v", k, v.arr, v.n, v.response, resp)
+			}
+		}
+	}
+
+	resp, err := Quantiles([]float64{1, nan}, 2)
+	if err != nil || len(resp) != 1 || !math.IsNaN(resp[0]) {
+		t.Errorf("test [propagate NaN] failed on method Quantiles, expected to be [NaN] got %v and error %v", resp, err)
+	}
+}
@@ -0,0 +1,290 @@
+package stats
+
+import (
+	"errors"
+	"math"
+)
+
+// Number is a constraint for element types of arrays accepted by stats functions
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// NaNPolicy defines how NaN values of array are handled
+type NaNPolicy int
+
+const (
+	// PropagateNaN makes result NaN if array contains NaN, functions which can't return NaN return ErrNotFinite
+	PropagateNaN NaNPolicy = iota
+	// SkipNaN ignores NaN values as if they were not in array
+	SkipNaN
+)
+
+// Options are optional params of stats functions
+type Options struct {
+	// NaN defines how NaN values are handled, PropagateNaN by default
+	NaN NaNPolicy
+	// Interpolation is used by Percentile, Median and Quantiles when percentile falls between two values, Linear by default
+	Interpolation Interpolation
+}
+
+var (
+	// ErrEmpty array has no values (or only NaN values which are skipped)
+	ErrEmpty = errors.New("array is empty")
+	// ErrNotEnoughValues array has too few values, e.g. sample variance needs at least two values
+	ErrNotEnoughValues = errors.New("not enough values")
+	// ErrOutOfRange argument is out of the allowed range
+	ErrOutOfRange = errors.New("argument is out of range")
+	// ErrNotFinite array contains NaN or infinite value which can't be used for the result
+	ErrNotFinite = errors.New("value is not finite")
+	// ErrZeroStdDev standard deviation of array is zero
+	ErrZeroStdDev = errors.New("standard deviation is zero")
+)
+
+// Sum returns sum of values, Neumaier summation is used so the result doesn't depend on the order of values
+//
+// Sum([]float64{1e100, 1, -1e100}) # => 1
+func Sum[S ~[]T, T Number](arr S, opts ...Options) float64 {
+	values, nan := toFloats(arr, options(opts))
+	if nan {
+		return math.NaN()
+	}
+
+	return sum(values)
+}
+
+// Mean returns arithmetic mean of values
+//
+// Mean([]int{1, 2, 3, 4}) # => 2.5
+func Mean[S ~[]T, T Number](arr S, opts ...Options) (float64, error) {
+	values, nan := toFloats(arr, options(opts))
+	switch {
+	case nan:
+		return math.NaN(), nil
+	case len(values) == 0:
+		return 0, ErrEmpty
+	}
+
+	return mean(values), nil
+}
+
+// Min returns min value, NaN is handled according to NaNPolicy
+//
+// Min([]float64{2, math.NaN(), 1}, Options{NaN: SkipNaN}) # => 1
+func Min[S ~[]T, T Number](arr S, opts ...Options) (float64, error) {
+	return extreme(arr, options(opts), func(a, b float64) bool { return a < b })
+}
+
+// Max returns max value, NaN is handled according to NaNPolicy
+//
+// Max([]float64{2, math.NaN(), 1})                        # => NaN
+func Max[S ~[]T, T Number](arr S, opts ...Options) (float64, error) {
+	return extreme(arr, options(opts), func(a, b float64) bool { return a > b })
+}
+
+// Mode returns the most frequent values in order of their first occurrence
+//
+// Mode([]int{1, 2, 2, 3, 3}) # => [2, 3]
+func Mode[S ~[]T, T Number](arr S, opts ...Options) ([]T, error) {
+	o := options(opts)
+
+	counts := make(map[T]int, len(arr))
+	values := make([]T, 0)
+	maxCount := 0
+
+	for _, el := range arr {
+		if isNaN(el) {
+			if o.NaN == PropagateNaN {
+				return []T{el}, nil
+			}
+			continue
+		}
+
+		if counts[el] == 0 {
+			values = append(values, el)
+		}
+		counts[el]++
+		maxCount = max(maxCount, counts[el])
+	}
+
+	if maxCount == 0 {
+		return nil, ErrEmpty
+	}
+
+	modes := make([]T, 0)
+	for _, el := range values {
+		if counts[el] == maxCount {
+			modes = append(modes, el)
+		}
+	}
+
+	return modes, nil
+}
+
+// Variance returns sample variance of values, at least two values are required
+//
+// Variance([]float64{2, 4, 4, 4, 5, 5, 7, 9}) # => 4.571428571428571
+func Variance[S ~[]T, T Number](arr S, opts ...Options) (float64, error) {
+	return variance(arr, options(opts), 1)
+}
+
+// PopulationVariance returns population variance of values
+//
+// PopulationVariance([]float64{2, 4, 4, 4, 5, 5, 7, 9}) # => 4
+func PopulationVariance[S ~[]T, T Number](arr S, opts ...Options) (float64, error) {
+	return variance(arr, options(opts), 0)
+}
+
+// StdDev returns sample standard deviation of values, at least two values are required
+func StdDev[S ~[]T, T Number](arr S, opts ...Options) (float64, error) {
+	v, err := Variance(arr, opts...)
+
+	return math.Sqrt(v), err
+}
+
+// PopulationStdDev returns population standard deviation of values
+//
+// PopulationStdDev([]float64{2, 4, 4, 4, 5, 5, 7, 9}) # => 2
+func PopulationStdDev[S ~[]T, T Number](arr S, opts ...Options) (float64, error) {
+	v, err := PopulationVariance(arr, opts...)
+
+	return math.Sqrt(v), err
+}
+
+// ZScores returns number of population standard deviations every value is away from the mean.
+// Skipped NaN values stay NaN in the result, so it has the same length as array
+//
+// ZScores([]float64{2, 4, 4, 4, 5, 5, 7, 9}) # => [-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2]
+func ZScores[S ~[]T, T Number](arr S, opts ...Options) ([]float64, error) {
+	o := options(opts)
+
+	values, nan := toFloats(arr, o)
+	if nan {
+		return nil, ErrNotFinite
+	}
+
+	if len(values) == 0 {
+		return nil, ErrEmpty
+	}
+
+	m := mean(values)
+	stdDev := math.Sqrt(sumOfSquares(values, m) / float64(len(values)))
+	if stdDev == 0 {
+		return nil, ErrZeroStdDev
+	}
+
+	scores := make([]float64, 0, len(arr))
+	for _, el := range arr {
+		scores = append(scores, (float64(el)-m)/stdDev)
+	}
+
+	return scores, nil
+}
+
+func options(opts []Options) Options {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+
+	return Options{}
+}
+
+// toFloats converts values to float64, NaN values are skipped for SkipNaN policy,
+// for PropagateNaN policy true is returned if array contains NaN
+func toFloats[S ~[]T, T Number](arr S, o Options) ([]float64, bool) {
+	values := make([]float64, 0, len(arr))
+
+	for _, el := range arr {
+		if isNaN(el) {
+			if o.NaN == PropagateNaN {
+				return nil, true
+			}
+			continue
+		}
+
+		values = append(values, float64(el))
+	}
+
+	return values, false
+}
+
+// isNaN checks if value is NaN, NaN is the only value which isn't equal to itself
+func isNaN[T Number](el T) bool {
+	return el != el
+}
+
+// sum implements Neumaier summation, the lost low-order bits are accumulated separately and added at the end
+func sum(values []float64) float64 {
+	var s, c float64
+
+	for _, v := range values {
+		t := s + v
+		if math.Abs(s) >= math.Abs(v) {
+			c += (s - t) + v
+		} else {
+			c += (v - t) + s
+		}
+		s = t
+	}
+
+	// compensation is meaningless once the sum overflows
+	if math.IsInf(s, 0) || math.IsNaN(s) {
+		return s
+	}
+
+	return s + c
+}
+
+func mean(values []float64) float64 {
+	return sum(values) / float64(len(values))
+}
+
+// sumOfSquares returns sum of squared deviations from the mean, corrected for the rounding error of the mean
+func sumOfSquares(values []float64, m float64) float64 {
+	squares := make([]float64, 0, len(values))
+	deviations := make([]float64, 0, len(values))
+
+	for _, v := range values {
+		squares = append(squares, (v-m)*(v-m))
+		deviations = append(deviations, v-m)
+	}
+
+	d := sum(deviations)
+
+	return sum(squares) - d*d/float64(len(values))
+}
+
+func variance[S ~[]T, T Number](arr S, o Options, ddof int) (float64, error) {
+	values, nan := toFloats(arr, o)
+	switch {
+	case nan:
+		return math.NaN(), nil
+	case len(values) == 0:
+		return 0, ErrEmpty
+	case len(values) <= ddof:
+		return 0, ErrNotEnoughValues
+	}
+
+	return sumOfSquares(values, mean(values)) / float64(len(values)-ddof), nil
+}
+
+func extreme[S ~[]T, T Number](arr S, o Options, better func(a, b float64) bool) (float64, error) {
+	values, nan := toFloats(arr, o)
+	switch {
+	case nan:
+		return math.NaN(), nil
+	case len(values) == 0:
+		return 0, ErrEmpty
+	}
+
+	result := values[0]
+	for _, v := range values[1:] {
+		if better(v, result) {
+			result = v
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,250 @@
+package stats
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	arrayOfFloats "github.com/maki5/rutils/arrays/of_float64"
+	arrayOfInts "github.com/maki5/rutils/arrays/of_int"
+)
+
+var nan = math.NaN()
+
+func TestSum(t *testing.T) {
+	type testData struct {
+		arr      []float64
+		opts     []Options
+		response float64
+	}
+
+	examples := map[string]testData{
+		"empty arr":          testData{arr: []float64{}, response: 0},
+		"multiple elements":  testData{arr: []float64{1.5, 2.5, -1}, response: 3},
+		"compensated":        testData{arr: []float64{1e100, 1, -1e100}, response: 1},
+		"small increments":   testData{arr: []float64{0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1}, response: 1},
+		"infinity":           testData{arr: []float64{math.Inf(1), 1}, response: math.Inf(1)},
+		"skip NaN":           testData{arr: []float64{1, nan, 2}, opts: []Options{{NaN: SkipNaN}}, response: 3},
+		"propagate NaN":      testData{arr: []float64{1, nan, 2}, response: nan},
+		"opposite infinites": testData{arr: []float64{math.Inf(1), math.Inf(-1)}, response: nan},
+	}
+
+	for k, v := range examples {
+		resp := Sum(v.arr, v.opts...)
+
+		if !sameFloat(resp, v.response) {
+			t.Errorf("test [%v] failed on method Sum with params(arr: %v), expected to be %v got %v", k, v.arr, v.response, resp)
+		}
+	}
+
+	if resp := Sum(arrayOfInts.IntArray{1, 2, 3}); resp != 6 {
+		t.Errorf("test [int array] failed on method Sum, expected to be %v got %v", 6, resp)
+	}
+}
+
+func TestMeanMinMax(t *testing.T) {
+	type testData struct {
+		arr  []float64
+		opts []Options
+		mean float64
+		min  float64
+		max  float64
+		err  error
+	}
+
+	examples := map[string]testData{
+		"empty arr":         testData{arr: []float64{}, err: ErrEmpty},
+		"only NaN skipped":  testData{arr: []float64{nan}, opts: []Options{{NaN: SkipNaN}}, err: ErrEmpty},
+		"one element":       testData{arr: []float64{2}, mean: 2, min: 2, max: 2},
+		"multiple elements": testData{arr: []float64{1, 4, -2, 5}, mean: 2, min: -2, max: 5},
+		"NaN first skipped": testData{arr: []float64{nan, 4, 2}, opts: []Options{{NaN: SkipNaN}}, mean: 3, min: 2, max: 4},
+		"propagate NaN":     testData{arr: []float64{4, nan, 2}, mean: nan, min: nan, max: nan},
+	}
+
+	for k, v := range examples {
+		arr := arrayOfFloats.FloatArray(v.arr)
+
+		mean, err := Mean(arr, v.opts...)
+		if err != v.err || (err == nil && !sameFloat(mean, v.mean)) {
+			t.Errorf("test [%v] failed on method Mean with params(arr: %v), expected to be %v got %v and error to be %v got %v", k, v.arr, v.mean, mean, v.err, err)
+		}
+
+		min, err := Min(arr, v.opts...)
+		if err != v.err || (err == nil && !sameFloat(min, v.min)) {
+			t.Errorf("test [%v] failed on method Min with params(arr: %v), expected to be %v got %v and error to be %v got %v", k, v.arr, v.min, min, v.err, err)
+		}
+
+		max, err := Max(arr, v.opts...)
+		if err != v.err || (err == nil && !sameFloat(max, v.max)) {
+			t.Errorf("test [%v] failed on method Max with params(arr: %v), expected to be %v got %v and error to be %v got %v", k, v.arr, v.max, max, v.err, err)
+		}
+	}
+}
+
+func TestMode(t *testing.T) {
+	type testData struct {
+		arr      []float64
+		opts     []Options
+		response []float64
+		err      error
+	}
+
+	examples := map[string]testData{
+		"empty arr":         testData{arr: []float64{}, response: nil, err: ErrEmpty},
+		"one mode":          testData{arr: []float64{3, 1, 3}, response: []float64{3}},
+		"multiple modes":    testData{arr: []float64{3, 1, 2, 2, 3}, response: []float64{3, 2}},
+		"all unique":        testData{arr: []float64{2, 1}, response: []float64{2, 1}},
+		"skip NaN":          testData{arr: []float64{nan, nan, 1}, opts: []Options{{NaN: SkipNaN}}, response: []float64{1}},
+		"only NaN skipped":  testData{arr: []float64{nan}, opts: []Options{{NaN: SkipNaN}}, response: nil, err: ErrEmpty},
+		"propagate NaN":     testData{arr: []float64{1, 1, nan}, response: []float64{nan}},
+		"mode changes late": testData{arr: []float64{1, 2, 2, 1, 1}, response: []float64{1}},
+	}
+
+	for k, v := range examples {
+		resp, err := Mode(v.arr, v.opts...)
+
+		if err != v.err || len(resp) != len(v.response) {
+			t.Errorf("test [%v] failed on method Mode with params(arr: %v), expected to be %v got %v and error to be %v got %v", k, v.arr, v.response, resp, v.err, err)
+			continue
+		}
+
+		for i := range resp {
+			if !sameFloat(resp[i], v.response[i]) {
+				t.Errorf("test [%v] failed on method Mode with params(arr: %v), expected to be %v got %v", k, v.arr, v.response, resp)
+			}
+		}
+	}
+}
+
+func TestVarianceAndStdDev(t *testing.T) {
+	type testData struct {
+		arr                []float64
+		variance           float64
+		varianceErr        error
+		populationVariance float64
+		populationErr      error
+	}
+
+	examples := map[string]testData{
+		"empty arr":   testData{arr: []float64{}, varianceErr: ErrEmpty, populationErr: ErrEmpty},
+		"one element": testData{arr: []float64{3}, varianceErr: ErrNotEnoughValues, populationVariance: 0},
+		"multiple elements": testData{arr: []float64{2, 4, 4, 4, 5, 5, 7, 9}, variance: 32.0 / 7,
+			populationVariance: 4},
+		"big offset": testData{arr: []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}, variance: 30, populationVariance: 22.5},
+	}
+
+	for k, v := range examples {
+		resp, err := Variance(v.arr)
+		if err != v.varianceErr || !sameFloat(resp, v.variance) {
+			t.Errorf("test [%v] failed on method Variance with params(arr: %v), expected to be %v got %v and error to be %v got %v", k, v.arr, v.variance, resp, v.varianceErr, err)
+		}
+
+		resp, err = StdDev(v.arr)
+		if err != v.varianceErr || !sameFloat(resp, math.Sqrt(v.variance)) {
+			t.Errorf("test [%v] failed on method StdDev with params(arr: %v), expected to be %v got %v and error to be %v got %v", k, v.arr, math.Sqrt(v.variance), resp, v.varianceErr, err)
+		}
+
+		resp, err = PopulationVariance(v.arr)
+		if err != v.populationErr || !sameFloat(resp, v.populationVariance) {
+			t.Errorf("test [%v] failed on method PopulationVariance with params(arr: %v), expected to be %v got %v and error to be %v got %v", k, v.arr, v.populationVariance, resp, v.populationErr, err)
+		}
+
+		resp, err = PopulationStdDev(v.arr)
+		if err != v.populationErr || !sameFloat(resp, math.Sqrt(v.populationVariance)) {
+			t.Errorf("test [%v] failed on method PopulationStdDev with params(arr: %v), expected to be %v got %v and error to be %v got %v", k, v.arr, math.Sqrt(v.populationVariance), resp, v.populationErr, err)
+		}
+	}
+
+	if resp, err := Variance([]float64{1, nan, 3}); err != nil || !math.IsNaN(resp) {
+		t.Errorf("test [propagate NaN] failed on method Variance, expected to be NaN got %v and error %v", resp, err)
+	}
+
+	if resp, err := Variance([]float64{1, nan, 3}, Options{NaN: SkipNaN}); err != nil || resp != 2 {
+		t.Errorf("test [skip NaN] failed on method Variance, expected to be 2 got %v and error %v", resp, err)
+	}
+}
+
+func TestZScores(t *testing.T) {
+	type testData struct {
+		arr      []float64
+		opts     []Options
+		response []float64
+		err      error
+	}
+
+	examples := map[string]testData{
+		"empty arr":         testData{arr: []float64{}, err: ErrEmpty},
+		"equal values":      testData{arr: []float64{2, 2}, err: ErrZeroStdDev},
+		"propagate NaN":     testData{arr: []float64{1, nan}, err: ErrNotFinite},
+		"multiple elements": testData{arr: []float64{2, 4, 4, 4, 5, 5, 7, 9}, response: []float64{-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2}},
+		"skip NaN":          testData{arr: []float64{1, nan, 3}, opts: []Options{{NaN: SkipNaN}}, response: []float64{-1, nan, 1}},
+	}
+
+	for k, v := range examples {
+		resp, err := ZScores(v.arr, v.opts...)
+
+		if err != v.err || len(resp) != len(v.response) {
+			t.Errorf("test [%v] failed on method ZScores with params(arr: %v), expected to be %v got %v and error to be %v got %v", k, v.arr, v.response, resp, v.err, err)
+			continue
+		}
+
+		for i := range resp {
+			if !sameFloat(resp[i], v.response[i]) {
+				t.Errorf("test [%v] failed on method ZScores with params(arr: %v), expected to be %v got %v", k, v.arr, v.response, resp)
+			}
+		}
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	type testData struct {
+		arr      []int
+		bins     int
+		response []Bin
+		err      error
+	}
+
+	examples := map[string]testData{
+		"empty arr":    testData{arr: []int{}, bins: 2, err: ErrEmpty},
+		"invalid bins": testData{arr: []int{1}, bins: 0, err: ErrOutOfRange},
+		"equal values": testData{arr: []int{3, 3}, bins: 1, response: []Bin{{Min: 2.5, Max: 3.5, Count: 2}}},
+		"two bins":     testData{arr: []int{1, 2, 2, 3, 5}, bins: 2, response: []Bin{{Min: 1, Max: 3, Count: 3}, {Min: 3, Max: 5, Count: 2}}},
+		"empty bin": testData{arr: []int{0, 1, 9, 10}, bins: 5,
+			response: []Bin{{Min: 0, Max: 2, Count: 2}, {Min: 2, Max: 4}, {Min: 4, Max: 6}, {Min: 6, Max: 8}, {Min: 8, Max: 10, Count: 2}}},
+	}
+
+	for k, v := range examples {
+		resp, err := Histogram(v.arr, v.bins)
+
+		if err != v.err || !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed on method Histogram with params(arr: %v, bins: %v), expected to be %v got %v and error to be %v got %v",
+				k, v.arr, v.bins, v.response, resp, v.err, err)
+		}
+	}
+
+	extreme, err := Histogram([]float64{-math.MaxFloat64, 0, math.MaxFloat64}, 3)
+	if err != nil || len(extreme) != 3 || extreme[0].Count != 1 || extreme[1].Count != 1 || extreme[2].Count != 1 {
+		t.Errorf("test [extreme range] failed on method Histogram, expected one value in every bin got %v and error %v", extreme, err)
+	}
+
+	if _, err := Histogram([]float64{1, math.Inf(1)}, 2); err != ErrNotFinite {
+		t.Errorf("test [infinity] failed on method Histogram, expected error to be %v got %v", ErrNotFinite, err)
+	}
+
+	if _, err := Histogram([]float64{1, nan}, 2); err != ErrNotFinite {
+		t.Errorf("test [propagate NaN] failed on method Histogram, expected error to be %v got %v", ErrNotFinite, err)
+	}
+}
+
+// sameFloat compares floats with relative tolerance, NaN is equal to NaN
+func sameFloat(a, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && math.IsNaN(b)
+	}
+
+	if a == b {
+		return true
+	}
+
+	return math.Abs(a-b) <= 1e-12*math.Max(math.Abs(a), math.Abs(b))
+}
@@ -0,0 +1,116 @@
+package arrays
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorMode defines how Try functions handle errors returned for elements of array
+type ErrorMode int
+
+const (
+	// FailFast stops at the first error and returns it, it is used by default
+	FailFast ErrorMode = iota
+	// CollectErrors calls the function for all elements and returns all errors joined with errors.Join,
+	// every joined error is ElementError
+	CollectErrors
+)
+
+// ElementError is returned by Try functions, it keeps index of the element for which the error was returned
+type ElementError struct {
+	Index int
+	Err   error
+}
+
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("element %v: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error returned for the element
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// TryMap returns values returned by the provided function, ElementError is returned if the function fails for any element
+//
+// TryMap([]string{"1", "2"}, strconv.Atoi)                     # => [1, 2]
+//
+// TryMap([]string{"1", "x", "y"}, strconv.Atoi)                # => error: element 1: strconv.Atoi: parsing "x": invalid syntax
+//
+// TryMap([]string{"1", "x", "y"}, strconv.Atoi, CollectErrors) # => errors for elements 1 and 2
+func TryMap[S ~[]T, T any, R any](arr S, exec func(el T) (R, error), mode ...ErrorMode) ([]R, error) {
+	resArr := make([]R, 0, len(arr))
+
+	err := try(arr, mode, func(el T) error {
+		r, err := exec(el)
+		if err == nil {
+			resArr = append(resArr, r)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resArr, nil
+}
+
+// TrySelect returns elements for which the provided function returns true, ElementError is returned if the function fails for any element
+func TrySelect[S ~[]T, T any](arr S, exec func(el T) (bool, error), mode ...ErrorMode) (S, error) {
+	resArr := make(S, 0)
+
+	err := try(arr, mode, func(el T) error {
+		ok, err := exec(el)
+		if ok && err == nil {
+			resArr = append(resArr, el)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resArr, nil
+}
+
+// TryReduce combines all elements with the provided function starting from init value,
+// ElementError is returned if the function fails for any element, the result is zero value of accumulator on error
+//
+// TryReduce([]string{"1", "2"}, 0, func(sum int, el string) (int, error) { n, err := strconv.Atoi(el); return sum + n, err }) # => 3
+func TryReduce[S ~[]T, T any, A any](arr S, init A, exec func(acc A, el T) (A, error), mode ...ErrorMode) (A, error) {
+	acc := init
+
+	err := try(arr, mode, func(el T) error {
+		r, err := exec(acc, el)
+		if err == nil {
+			acc = r
+		}
+		return err
+	})
+	if err != nil {
+		var zero A
+		return zero, err
+	}
+
+	return acc, nil
+}
+
+// try calls exec for elements of array and wraps returned errors into ElementError
+func try[S ~[]T, T any](arr S, mode []ErrorMode, exec func(el T) error) error {
+	collect := len(mode) > 0 && mode[0] == CollectErrors
+
+	var errs []error
+	for i, el := range arr {
+		err := exec(el)
+		if err == nil {
+			continue
+		}
+
+		if !collect {
+			return &ElementError{Index: i, Err: err}
+		}
+		errs = append(errs, &ElementError{Index: i, Err: err})
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,104 @@
+package arrays
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestTryMap(t *testing.T) {
+	type testData struct {
+		arr      []string
+		mode     []ErrorMode
+		response []int
+		indexes  []int
+	}
+
+	examples := map[string]testData{
+		"empty arr":          testData{arr: []string{}, response: []int{}},
+		"valid elements":     testData{arr: []string{"1", "2", "3"}, response: []int{1, 2, 3}},
+		"fail fast":          testData{arr: []string{"1", "x", "y"}, indexes: []int{1}},
+		"collect errors":     testData{arr: []string{"1", "x", "y"}, mode: []ErrorMode{CollectErrors}, indexes: []int{1, 2}},
+		"collect no errors":  testData{arr: []string{"4"}, mode: []ErrorMode{CollectErrors}, response: []int{4}},
+		"explicit fail fast": testData{arr: []string{"x", "y"}, mode: []ErrorMode{FailFast}, indexes: []int{0}},
+	}
+
+	for k, v := range examples {
+		initialArr := Array[string](v.arr)
+
+		resp, err := TryMap(initialArr, strconv.Atoi, v.mode...)
+		if !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed on method TryMap with params(initialArr: %v), expected to be %v got %v", k, v.arr, v.response, resp)
+		}
+
+		if indexes := errorIndexes(err); !reflect.DeepEqual(indexes, v.indexes) {
+			t.Errorf("test [%v] failed on method TryMap with params(initialArr: %v), expected errors for elements %v got %v", k, v.arr, v.indexes, err)
+		}
+
+		if err != nil && !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("test [%v] failed on method TryMap with params(initialArr: %v), expected error to wrap %v got %v", k, v.arr, strconv.ErrSyntax, err)
+		}
+	}
+}
+
+func TestTrySelect(t *testing.T) {
+	isEven := func(el string) (bool, error) {
+		n, err := strconv.Atoi(el)
+		return n%2 == 0, err
+	}
+
+	resp, err := TrySelect(ComparableArray[string]{"1", "2", "4"}, isEven)
+	if err != nil || !reflect.DeepEqual(resp, ComparableArray[string]{"2", "4"}) {
+		t.Errorf("test [valid elements] failed on method TrySelect, expected to be [2 4] got %v and error %v", resp, err)
+	}
+
+	resp, err = TrySelect([]string{"2", "a", "b"}, isEven, CollectErrors)
+	if resp != nil || !reflect.DeepEqual(errorIndexes(err), []int{1, 2}) {
+		t.Errorf("test [collect errors] failed on method TrySelect, expected errors for elements [1 2] got %v and %v", err, resp)
+	}
+}
+
+func TestTryReduce(t *testing.T) {
+	sum := func(acc int, el string) (int, error) {
+		n, err := strconv.Atoi(el)
+		return acc + n, err
+	}
+
+	resp, err := TryReduce([]string{"1", "2", "3"}, 10, sum)
+	if err != nil || resp != 16 {
+		t.Errorf("test [valid elements] failed on method TryReduce, expected to be 16 got %v and error %v", resp, err)
+	}
+
+	resp, err = TryReduce([]string{"1", "x", "3"}, 0, sum)
+	if resp != 0 || !reflect.DeepEqual(errorIndexes(err), []int{1}) {
+		t.Errorf("test [fail fast] failed on method TryReduce, expected error for element 1 got %v and %v", err, resp)
+	}
+
+	resp, err = TryReduce([]string{"x", "2", "y"}, 0, sum, CollectErrors)
+	if resp != 0 || !reflect.DeepEqual(errorIndexes(err), []int{0, 2}) {
+		t.Errorf("test [collect errors] failed on method TryReduce, expected errors for elements [0 2] got %v and %v", err, resp)
+	}
+}
+
+// errorIndexes returns indexes of all ElementErrors joined into err
+func errorIndexes(err error) []int {
+	if err == nil {
+		return nil
+	}
+
+	errs := []error{err}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	}
+
+	indexes := make([]int, 0, len(errs))
+	for _, e := range errs {
+		var elErr *ElementError
+		if errors.As(e, &elErr) {
+			indexes = append(indexes, elErr.Index)
+		}
+	}
+
+	return indexes
+}
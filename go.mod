@@ -1,3 +1,3 @@
 module github.com/maki5/rutils
 
-go 1.12
+go 1.23
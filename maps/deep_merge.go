@@ -0,0 +1,212 @@
+package maps
+
+import (
+	"reflect"
+)
+
+// SliceStrategy defines how DeepMerge merges slices stored under the same key
+type SliceStrategy int
+
+const (
+	// ReplaceSlices replaces slice of initial map with slice of given map
+	ReplaceSlices SliceStrategy = iota
+	// AppendSlices appends elements of given slice to elements of initial one
+	AppendSlices
+	// UnionSlices appends elements of given slice which initial slice doesn't contain yet
+	UnionSlices
+)
+
+// MergeOptions options of DeepMerge.
+// Conflict is called for keys present in both maps whose values are not both maps or both slices of the same type,
+// its result is stored under the key, by default value of given map wins
+type MergeOptions struct {
+	Slices   SliceStrategy
+	Conflict func(key, oldValue, newValue interface{}) interface{}
+}
+
+// DeepMerge merges initial map with given recursively, nested Map, map[string]interface{} and map[interface{}]interface{}
+// values are merged instead of being replaced, slices are merged according to the slice strategy.
+// Nested maps of initial map are not modified, merged copies replace them. Nested maps of the types above and slices taken from given map
+// are copied deeply, so the result doesn't share them with given map
+//
+// m := Map{"db": Map{"host": "localhost", "port": 5432}, "tags": []string{"a"}}
+//
+// m.DeepMerge(Map{"db": Map{"host": "db.local"}, "tags": []string{"b"}}, MergeOptions{Slices: AppendSlices})
+//
+// m # => Map{"db": Map{"host": "db.local", "port": 5432}, "tags": []string{"a", "b"}}
+func (m *Map) DeepMerge(otherMap Map, opts ...MergeOptions) {
+	var options MergeOptions
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+
+	if *m == nil {
+		*m = make(Map, len(otherMap))
+	}
+
+	deepMerge(*m, otherMap, options)
+}
+
+func deepMerge(m, otherMap Map, options MergeOptions) {
+	for k, newValue := range otherMap {
+		oldValue, ok := m[k]
+		if !ok {
+			m[k] = cloneValue(newValue)
+			continue
+		}
+
+		m[k] = mergeValues(k, oldValue, newValue, options)
+	}
+}
+
+func mergeValues(key, oldValue, newValue interface{}, options MergeOptions) interface{} {
+	oldMap, oldIsMap := toMergeable(oldValue)
+	newMap, newIsMap := toMergeable(newValue)
+	if oldIsMap && newIsMap {
+		merged := make(Map, len(oldMap))
+		for k, v := range oldMap {
+			merged[k] = v
+		}
+
+		deepMerge(merged, newMap, options)
+
+		return fromMergeable(merged, oldValue)
+	}
+
+	if merged, ok := mergeSlices(oldValue, newValue, options.Slices); ok {
+		return merged
+	}
+
+	if options.Conflict != nil {
+		return options.Conflict(key, oldValue, newValue)
+	}
+
+	return cloneValue(newValue)
+}
+
+// mergeSlices merges slices of the same type, false is returned if values are not slices of the same type
+func mergeSlices(oldValue, newValue interface{}, strategy SliceStrategy) (interface{}, bool) {
+	oldSlice, newSlice := reflect.ValueOf(oldValue), reflect.ValueOf(newValue)
+	if oldSlice.Kind() != reflect.Slice || newSlice.Kind() != reflect.Slice || oldSlice.Type() != newSlice.Type() {
+		return nil, false
+	}
+
+	if strategy == ReplaceSlices {
+		return cloneValue(newValue), true
+	}
+
+	merged := reflect.MakeSlice(oldSlice.Type(), 0, oldSlice.Len()+newSlice.Len())
+	merged = reflect.AppendSlice(merged, oldSlice)
+
+	for i := 0; i < newSlice.Len(); i++ {
+		el := newSlice.Index(i)
+		if strategy == UnionSlices && containsValue(merged, el) {
+			continue
+		}
+
+		merged = reflect.Append(merged, cloneElement(el))
+	}
+
+	return merged.Interface(), true
+}
+
+// cloneValue copies nested maps and slices of value, other values are returned as is
+func cloneValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case Map:
+		m := make(Map, len(v))
+		for k, el := range v {
+			m[k] = cloneValue(el)
+		}
+		return m
+	case map[interface{}]interface{}:
+		m := make(map[interface{}]interface{}, len(v))
+		for k, el := range v {
+			m[k] = cloneValue(el)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, el := range v {
+			m[k] = cloneValue(el)
+		}
+		return m
+	}
+
+	slice := reflect.ValueOf(value)
+	if slice.Kind() != reflect.Slice || slice.IsNil() {
+		return value
+	}
+
+	cloned := reflect.MakeSlice(slice.Type(), 0, slice.Len())
+	for i := 0; i < slice.Len(); i++ {
+		cloned = reflect.Append(cloned, cloneElement(slice.Index(i)))
+	}
+
+	return cloned.Interface()
+}
+
+// cloneElement copies element of slice, elements which are not interfaces, maps or slices are returned as is
+func cloneElement(el reflect.Value) reflect.Value {
+	switch el.Kind() {
+	case reflect.Interface, reflect.Map, reflect.Slice:
+		if el.IsNil() {
+			return el
+		}
+
+		cloned := reflect.ValueOf(cloneValue(el.Interface()))
+		if cloned.Type().AssignableTo(el.Type()) {
+			return cloned
+		}
+	}
+
+	return el
+}
+
+func containsValue(slice, el reflect.Value) bool {
+	for i := 0; i < slice.Len(); i++ {
+		if reflect.DeepEqual(slice.Index(i).Interface(), el.Interface()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// toMergeable converts nested map value to Map, false is returned if value is not a map DeepMerge recurses into
+func toMergeable(value interface{}) (Map, bool) {
+	switch v := value.(type) {
+	case Map:
+		return v, true
+	case map[interface{}]interface{}:
+		return Map(v), true
+	case map[string]interface{}:
+		m := make(Map, len(v))
+		for k, el := range v {
+			m[k] = el
+		}
+		return m, true
+	}
+
+	return nil, false
+}
+
+// fromMergeable converts merged map back to the type of original value, Map is returned if keys are not strings
+func fromMergeable(merged Map, original interface{}) interface{} {
+	switch original.(type) {
+	case map[interface{}]interface{}:
+		return map[interface{}]interface{}(merged)
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(merged))
+		for k, v := range merged {
+			key, ok := k.(string)
+			if !ok {
+				return merged
+			}
+			m[key] = v
+		}
+		return m
+	}
+
+	return merged
+}
@@ -0,0 +1,124 @@
+package maps
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDeepMerge(t *testing.T) {
+	type testData struct {
+		m        Map
+		other    Map
+		opts     []MergeOptions
+		response Map
+	}
+
+	sum := MergeOptions{Conflict: func(key, oldValue, newValue interface{}) interface{} {
+		return oldValue.(int) + newValue.(int)
+	}}
+
+	examples := map[string]testData{
+		"empty maps":     testData{m: Map{}, other: Map{}, response: Map{}},
+		"flat maps":      testData{m: Map{"a": 1, "b": 2}, other: Map{"b": 3, "c": 4}, response: Map{"a": 1, "b": 3, "c": 4}},
+		"nested Map":     testData{m: Map{"db": Map{"host": "localhost", "port": 5432}}, other: Map{"db": Map{"host": "db.local"}}, response: Map{"db": Map{"host": "db.local", "port": 5432}}},
+		"deeply nested":  testData{m: Map{"a": Map{"b": Map{"c": 1, "d": 2}}}, other: Map{"a": Map{"b": Map{"c": 3}}}, response: Map{"a": Map{"b": Map{"c": 3, "d": 2}}}},
+		"string maps":    testData{m: Map{"db": map[string]interface{}{"host": "localhost"}}, other: Map{"db": Map{"port": 5432}}, response: Map{"db": map[string]interface{}{"host": "localhost", "port": 5432}}},
+		"map over value": testData{m: Map{"db": "sqlite"}, other: Map{"db": Map{"host": "localhost"}}, response: Map{"db": Map{"host": "localhost"}}},
+		"replace slices": testData{m: Map{"tags": []string{"a", "b"}}, other: Map{"tags": []string{"b", "c"}}, response: Map{"tags": []string{"b", "c"}}},
+		"append slices":  testData{m: Map{"tags": []string{"a", "b"}}, other: Map{"tags": []string{"b", "c"}}, opts: []MergeOptions{{Slices: AppendSlices}}, response: Map{"tags": []string{"a", "b", "b", "c"}}},
+		"union slices":   testData{m: Map{"tags": []interface{}{"a", 1}}, other: Map{"tags": []interface{}{1, "c"}}, opts: []MergeOptions{{Slices: UnionSlices}}, response: Map{"tags": []interface{}{"a", 1, "c"}}},
+		"mixed slices":   testData{m: Map{"tags": []string{"a"}}, other: Map{"tags": []int{1}}, opts: []MergeOptions{{Slices: AppendSlices}}, response: Map{"tags": []int{1}}},
+		"conflict":       testData{m: Map{"a": 1, "b": Map{"c": 2}}, other: Map{"a": 10, "b": Map{"c": 20}, "d": 5}, opts: []MergeOptions{sum}, response: Map{"a": 11, "b": Map{"c": 22}, "d": 5}},
+	}
+
+	for k, v := range examples {
+		m := v.m
+		m.DeepMerge(v.other, v.opts...)
+
+		if !reflect.DeepEqual(m, v.response) {
+			t.Errorf("test [%v] failed on method DeepMerge with params(other: %v), expected to be %v got %v", k, v.other, v.response, m)
+		}
+	}
+}
+
+func TestDeepMergeKeepsNestedMaps(t *testing.T) {
+	defaults := Map{"db": Map{"host": "localhost"}}
+	tenant := Map{"db": Map{"host": "tenant.local"}}
+
+	config := Map{}
+	config.DeepMerge(defaults)
+	config.DeepMerge(tenant)
+
+	if host := defaults["db"].(Map)["host"]; host != "localhost" {
+		t.Errorf("test [nested maps] failed on method DeepMerge, expected base map to stay localhost got %v", host)
+	}
+
+	if host := config["db"].(Map)["host"]; host != "tenant.local" {
+		t.Errorf("test [nested maps] failed on method DeepMerge, expected to be tenant.local got %v", host)
+	}
+
+	var empty Map
+	empty.DeepMerge(Map{"a": 1})
+	if !reflect.DeepEqual(empty, Map{"a": 1}) {
+		t.Errorf("test [nil map] failed on method DeepMerge, expected to be %v got %v", Map{"a": 1}, empty)
+	}
+}
+
+func TestDeepMergeNilOverSlice(t *testing.T) {
+	strategies := map[string]SliceStrategy{"replace": ReplaceSlices, "append": AppendSlices, "union": UnionSlices}
+
+	for k, v := range strategies {
+		m := Map{"tags": []string{"a"}}
+		m.DeepMerge(Map{"tags": nil}, MergeOptions{Slices: v})
+
+		if !reflect.DeepEqual(m, Map{"tags": nil}) {
+			t.Errorf("test [%v] failed on method DeepMerge with params(other: map[tags:<nil>]), expected to be %v got %v", k, Map{"tags": nil}, m)
+		}
+	}
+}
+
+func TestDeepMergeConflictSkipsSlices(t *testing.T) {
+	called := false
+	conflict := MergeOptions{Conflict: func(key, oldValue, newValue interface{}) interface{} {
+		called = true
+		return "conflict"
+	}}
+
+	m := Map{"tags": []int{1}}
+	m.DeepMerge(Map{"tags": []int{2}}, conflict)
+
+	if called || !reflect.DeepEqual(m, Map{"tags": []int{2}}) {
+		t.Errorf("test [replace slices] failed on method DeepMerge, expected slice to be replaced without conflict got %v", m)
+	}
+
+	m = Map{"tags": []int{1}}
+	m.DeepMerge(Map{"tags": []string{"a"}}, conflict)
+
+	if !called || !reflect.DeepEqual(m, Map{"tags": "conflict"}) {
+		t.Errorf("test [different slices] failed on method DeepMerge, expected conflict to be called got %v", m)
+	}
+}
+
+func TestDeepMergeCopiesGivenValues(t *testing.T) {
+	overlay := Map{
+		"db":    Map{"host": "localhost"},
+		"env":   map[string]interface{}{"tags": []interface{}{Map{"a": 1}}},
+		"ports": []int{80},
+	}
+
+	m := Map{"ports": []int{8080}}
+	m.DeepMerge(overlay)
+
+	overlay["db"].(Map)["host"] = "changed"
+	overlay["env"].(map[string]interface{})["tags"].([]interface{})[0].(Map)["a"] = 2
+	overlay["ports"].([]int)[0] = 443
+
+	response := Map{
+		"db":    Map{"host": "localhost"},
+		"env":   map[string]interface{}{"tags": []interface{}{Map{"a": 1}}},
+		"ports": []int{80},
+	}
+	if !reflect.DeepEqual(m, response) {
+		t.Errorf("test [copies] failed on method DeepMerge, expected result not to share values with given map got %v", m)
+	}
+}
@@ -12,28 +12,6 @@ func (m *Map) Compact() {
 	}
 }
 
-// Keys returns map keys
-func (m *Map) Keys() *[]interface{} {
-	keys := make([]interface{}, 0, 0)
-
-	for k := range *m {
-		keys = append(keys, k)
-	}
-
-	return &keys
-}
-
-// Values returns map values
-func (m *Map) Values() *[]interface{} {
-	values := make([]interface{}, 0, 0)
-
-	for _, v := range *m {
-		values = append(values, v)
-	}
-
-	return &values
-}
-
 // FetchValues returns array containig the values asociated with the given keys
 func (m *Map) FetchValues(keys []interface{}) *[]interface{} {
 	values := make([]interface{}, 0, 0)
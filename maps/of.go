@@ -0,0 +1,125 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Of generic map type, it can be used wherever map[K]V is expected
+//
+// ages := Of[string, int]{"bob": 42}
+type Of[K comparable, V any] map[K]V
+
+// ErrTypeMismatch is returned by FromMap when key or value of Map has a different type
+var ErrTypeMismatch = errors.New("type mismatch")
+
+// Compact removes keys with nil values, values of types which can't be nil are kept
+func (m *Of[K, V]) Compact() {
+	for k, v := range *m {
+		if isNil(v) {
+			delete(*m, k)
+		}
+	}
+}
+
+// FetchValues returns array containing the values associated with the given keys, missing keys and nil values are skipped
+func (m *Of[K, V]) FetchValues(keys []K) *[]V {
+	values := make([]V, 0, len(keys))
+
+	for _, k := range keys {
+		if v, ok := (*m)[k]; ok && !isNil(v) {
+			values = append(values, v)
+		}
+	}
+
+	return &values
+}
+
+// Equal compare two maps, values are compared deeply
+func (m *Of[K, V]) Equal(mapToCompare Of[K, V]) bool {
+	if len(*m) != len(mapToCompare) {
+		return false
+	}
+
+	for k, v := range *m {
+		other, ok := mapToCompare[k]
+		if !ok || !reflect.DeepEqual(v, other) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Merge merges two initial map with given, values of the given map replace values of the same keys
+func (m *Of[K, V]) Merge(otherMap Of[K, V]) {
+	if *m == nil {
+		*m = make(Of[K, V], len(otherMap))
+	}
+
+	for k, v := range otherMap {
+		(*m)[k] = v
+	}
+}
+
+// ToMap converts map to Map
+func (m *Of[K, V]) ToMap() Map {
+	newMap := make(Map, len(*m))
+
+	for k, v := range *m {
+		newMap[k] = v
+	}
+
+	return newMap
+}
+
+// FromMap converts Map to typed map, ErrTypeMismatch is returned if any key or value has a different type.
+// nil values are converted to zero values of types which can be nil
+//
+// m := Map{"bob": 42}
+//
+// FromMap[string, int](m) # => Of[string, int]{"bob": 42}
+func FromMap[K comparable, V any](m Map) (Of[K, V], error) {
+	newMap := make(Of[K, V], len(m))
+
+	for k, v := range m {
+		key, ok := k.(K)
+		if !ok {
+			return nil, fmt.Errorf("%w: key %v is %T", ErrTypeMismatch, k, k)
+		}
+
+		value, ok := v.(V)
+		if !ok && !(v == nil && canBeNil[V]()) {
+			return nil, fmt.Errorf("%w: value of key %v is %T", ErrTypeMismatch, k, v)
+		}
+
+		newMap[key] = value
+	}
+
+	return newMap, nil
+}
+
+// isNil checks if value is nil, values of types which can't be nil are never nil
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return rv.IsNil()
+	}
+
+	return false
+}
+
+func canBeNil[V any]() bool {
+	switch reflect.TypeFor[V]().Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,148 @@
+package maps
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestOfCompact(t *testing.T) {
+	one := 1
+
+	ptrs := Of[string, *int]{"key1": &one, "key2": nil}
+	ptrs.Compact()
+	if !reflect.DeepEqual(ptrs, Of[string, *int]{"key1": &one}) {
+		t.Errorf("test [pointers] failed on method Compact, expected nil values to be removed got %v", ptrs)
+	}
+
+	ints := Of[string, int]{"key1": 1, "key2": 0}
+	ints.Compact()
+	if len(ints) != 2 {
+		t.Errorf("test [ints] failed on method Compact, expected zero values to be kept got %v", ints)
+	}
+
+	values := Of[int, interface{}]{1: "a", 2: nil, 3: []int(nil)}
+	values.Compact()
+	if !reflect.DeepEqual(values, Of[int, interface{}]{1: "a"}) {
+		t.Errorf("test [interfaces] failed on method Compact, expected nil values to be removed got %v", values)
+	}
+}
+
+func TestOfKeysAndValues(t *testing.T) {
+	type testData struct {
+		m      Of[string, int]
+		keys   []string
+		values []int
+	}
+
+	examples := map[string]testData{
+		"empty map":       testData{m: Of[string, int]{}, keys: []string{}, values: []int{}},
+		"map with values": testData{m: Of[string, int]{"key1": 1, "key2": 2}, keys: []string{"key1", "key2"}, values: []int{1, 2}},
+	}
+
+	for k, v := range examples {
+		keys := *v.m.Keys()
+		sort.Strings(keys)
+		if !reflect.DeepEqual(keys, v.keys) {
+			t.Errorf("test [%v] failed on method Keys with params(initialMap: %v), expected to be %v got %v", k, v.m, v.keys, keys)
+		}
+
+		values := *v.m.Values()
+		sort.Ints(values)
+		if !reflect.DeepEqual(values, v.values) {
+			t.Errorf("test [%v] failed on method Values with params(initialMap: %v), expected to be %v got %v", k, v.m, v.values, values)
+		}
+	}
+}
+
+func TestOfFetchValues(t *testing.T) {
+	type testData struct {
+		m        Of[string, []int]
+		keys     []string
+		response []([]int)
+	}
+
+	examples := map[string]testData{
+		"empty map":    testData{m: Of[string, []int]{}, keys: []string{"key1"}, response: []([]int){}},
+		"present keys": testData{m: Of[string, []int]{"key1": {1}, "key2": {2}}, keys: []string{"key2", "key1"}, response: []([]int){{2}, {1}}},
+		"missing keys": testData{m: Of[string, []int]{"key1": {1}, "key2": nil}, keys: []string{"key3", "key2", "key1"}, response: []([]int){{1}}},
+	}
+
+	for k, v := range examples {
+		resp := *v.m.FetchValues(v.keys)
+
+		if !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed on method FetchValues with params(initialMap: %v, keys: %v), expected to be %v got %v", k, v.m, v.keys, v.response, resp)
+		}
+	}
+}
+
+func TestOfEqual(t *testing.T) {
+	type testData struct {
+		m        Of[string, []string]
+		other    Of[string, []string]
+		response bool
+	}
+
+	examples := map[string]testData{
+		"empty maps":       testData{m: Of[string, []string]{}, other: Of[string, []string]{}, response: true},
+		"equal maps":       testData{m: Of[string, []string]{"a": {"b"}}, other: Of[string, []string]{"a": {"b"}}, response: true},
+		"different values": testData{m: Of[string, []string]{"a": {"b"}}, other: Of[string, []string]{"a": {"c"}}, response: false},
+		"different keys":   testData{m: Of[string, []string]{"a": nil}, other: Of[string, []string]{"b": nil}, response: false},
+		"different length": testData{m: Of[string, []string]{"a": nil}, other: Of[string, []string]{}, response: false},
+	}
+
+	for k, v := range examples {
+		if resp := v.m.Equal(v.other); resp != v.response {
+			t.Errorf("test [%v] failed on method Equal with params(initialMap: %v, other: %v), expected to be %v got %v", k, v.m, v.other, v.response, resp)
+		}
+	}
+}
+
+func TestOfMerge(t *testing.T) {
+	m := Of[string, int]{"key1": 1, "key2": 2}
+	m.Merge(Of[string, int]{"key2": 20, "key3": 3})
+
+	response := map[string]int{"key1": 1, "key2": 20, "key3": 3}
+	if !reflect.DeepEqual(map[string]int(m), response) {
+		t.Errorf("test [overlapping keys] failed on method Merge, expected to be %v got %v", response, m)
+	}
+
+	var empty Of[string, int]
+	empty.Merge(Of[string, int]{"key1": 1})
+	if len(empty) != 1 {
+		t.Errorf("test [nil map] failed on method Merge, expected to be map[key1:1] got %v", empty)
+	}
+}
+
+func TestOfConversion(t *testing.T) {
+	typed := Of[string, int]{"bob": 42, "alice": 30}
+
+	untyped := typed.ToMap()
+	if !reflect.DeepEqual(untyped, Map{"bob": 42, "alice": 30}) {
+		t.Errorf("test [to map] failed on method ToMap, expected to be %v got %v", typed, untyped)
+	}
+
+	resp, err := FromMap[string, int](untyped)
+	if err != nil || !resp.Equal(typed) {
+		t.Errorf("test [from map] failed on method FromMap, expected to be %v got %v and error %v", typed, resp, err)
+	}
+
+	if _, err = FromMap[string, int](Map{"bob": "42"}); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("test [wrong value type] failed on method FromMap, expected error to be %v got %v", ErrTypeMismatch, err)
+	}
+
+	if _, err = FromMap[string, int](Map{1: 42}); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("test [wrong key type] failed on method FromMap, expected error to be %v got %v", ErrTypeMismatch, err)
+	}
+
+	if _, err = FromMap[string, int](Map{"bob": nil}); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("test [nil int] failed on method FromMap, expected error to be %v got %v", ErrTypeMismatch, err)
+	}
+
+	ptrs, err := FromMap[string, *int](Map{"bob": nil})
+	if err != nil || len(ptrs) != 1 || ptrs["bob"] != nil {
+		t.Errorf("test [nil pointer] failed on method FromMap, expected to be map[bob:<nil>] got %v and error %v", ptrs, err)
+	}
+}
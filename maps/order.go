@@ -0,0 +1,251 @@
+package maps
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// Entry is a key/value pair of map
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// CompareKeys is the default comparator of keys of any type, it defines total order of keys of Map:
+// nil goes first, then booleans, numbers (compared by value), strings and other values compared by their type and formatted value.
+// Keys which are equal by value but have different types (1 and 1.0) are ordered by type name.
+// Pointer keys are compared by the values they point to. The order isn't stable between runs for keys whose formatted value
+// contains memory address: channels, pointers to equal values and structs or arrays with pointer fields
+//
+// CompareKeys(2, "a")   # => -1
+//
+// CompareKeys(10, 2.5)  # => 1
+func CompareKeys(a, b interface{}) int {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+
+	if c := cmp.Compare(keyRank(va), keyRank(vb)); c != 0 {
+		return c
+	}
+
+	if !va.IsValid() {
+		return 0
+	}
+
+	var c int
+	switch keyRank(va) {
+	case boolRank:
+		c = compareBools(va.Bool(), vb.Bool())
+	case numberRank:
+		c = compareNumbers(va, vb)
+	case stringRank:
+		c = cmp.Compare(va.String(), vb.String())
+	default:
+		c = cmp.Compare(va.Type().String(), vb.Type().String())
+		if c == 0 {
+			c = compareOthers(va, vb)
+		}
+	}
+
+	if c != 0 {
+		return c
+	}
+
+	return cmp.Compare(va.Type().String(), vb.Type().String())
+}
+
+// Keys returns map keys ordered by CompareKeys, so keys are listed in the same order on every call
+// and line up positionally with Values. Every call sorts keys using reflection in O(n log n)
+func (m *Map) Keys() *[]interface{} {
+	return m.SortedKeys()
+}
+
+// Values returns map values ordered by their keys, value at index i belongs to the key at index i of Keys.
+// Every call sorts keys using reflection in O(n log n)
+func (m *Map) Values() *[]interface{} {
+	return m.ValuesSortedByKey()
+}
+
+// SortedKeys returns map keys ordered by the given comparator, CompareKeys is used by default
+//
+// m := Map{"b": 1, 2: 2, "a": 3}
+//
+// m.SortedKeys() # => [2, "a", "b"]
+func (m *Map) SortedKeys(compare ...func(a, b interface{}) int) *[]interface{} {
+	entries := m.Entries(compare...)
+	keys := make([]interface{}, 0, len(entries))
+
+	for _, e := range entries {
+		keys = append(keys, e.Key)
+	}
+
+	return &keys
+}
+
+// ValuesSortedByKey returns map values ordered by their keys using the given comparator, CompareKeys is used by default
+func (m *Map) ValuesSortedByKey(compare ...func(a, b interface{}) int) *[]interface{} {
+	entries := m.Entries(compare...)
+	values := make([]interface{}, 0, len(entries))
+
+	for _, e := range entries {
+		values = append(values, e.Value)
+	}
+
+	return &values
+}
+
+// Entries returns key/value pairs ordered by keys using the given comparator, CompareKeys is used by default
+//
+// m := Map{"b": 1, "a": 2}
+//
+// m.Entries() # => [{a 2}, {b 1}]
+func (m *Map) Entries(compare ...func(a, b interface{}) int) []Entry[interface{}, interface{}] {
+	return sortedEntries(*m, compare)
+}
+
+// Keys returns map keys ordered by CompareKeys, so keys are listed in the same order on every call
+// and line up positionally with Values. Every call sorts keys using reflection in O(n log n)
+func (m *Of[K, V]) Keys() *[]K {
+	return m.SortedKeys()
+}
+
+// Values returns map values ordered by their keys, value at index i belongs to the key at index i of Keys.
+// Every call sorts keys using reflection in O(n log n)
+func (m *Of[K, V]) Values() *[]V {
+	return m.ValuesSortedByKey()
+}
+
+// SortedKeys returns map keys ordered by the given comparator, CompareKeys is used by default
+func (m *Of[K, V]) SortedKeys(compare ...func(a, b K) int) *[]K {
+	entries := m.Entries(compare...)
+	keys := make([]K, 0, len(entries))
+
+	for _, e := range entries {
+		keys = append(keys, e.Key)
+	}
+
+	return &keys
+}
+
+// ValuesSortedByKey returns map values ordered by their keys using the given comparator, CompareKeys is used by default
+func (m *Of[K, V]) ValuesSortedByKey(compare ...func(a, b K) int) *[]V {
+	entries := m.Entries(compare...)
+	values := make([]V, 0, len(entries))
+
+	for _, e := range entries {
+		values = append(values, e.Value)
+	}
+
+	return &values
+}
+
+// Entries returns key/value pairs ordered by keys using the given comparator, CompareKeys is used by default
+func (m *Of[K, V]) Entries(compare ...func(a, b K) int) []Entry[K, V] {
+	return sortedEntries(*m, compare)
+}
+
+func sortedEntries[M ~map[K]V, K comparable, V any](m M, compare []func(a, b K) int) []Entry[K, V] {
+	compareKeys := func(a, b K) int { return CompareKeys(a, b) }
+	if len(compare) > 0 {
+		compareKeys = compare[0]
+	}
+
+	entries := make([]Entry[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+
+	slices.SortStableFunc(entries, func(a, b Entry[K, V]) int {
+		return compareKeys(a.Key, b.Key)
+	})
+
+	return entries
+}
+
+const (
+	nilRank = iota
+	boolRank
+	numberRank
+	stringRank
+	otherRank
+)
+
+func keyRank(v reflect.Value) int {
+	if !v.IsValid() {
+		return nilRank
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return boolRank
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return numberRank
+	case reflect.String:
+		return stringRank
+	}
+
+	return otherRank
+}
+
+// compareOthers compares values of the same type which are not nil, booleans, numbers or strings
+func compareOthers(a, b reflect.Value) int {
+	if a.Kind() != reflect.Pointer {
+		return cmp.Compare(fmt.Sprintf("%#v", a.Interface()), fmt.Sprintf("%#v", b.Interface()))
+	}
+
+	switch {
+	case a.IsNil() || b.IsNil():
+		return compareBools(!a.IsNil(), !b.IsNil())
+	case a.Pointer() == b.Pointer():
+		return 0
+	}
+
+	return CompareKeys(a.Elem().Interface(), b.Elem().Interface())
+}
+
+func compareBools(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	}
+
+	return 1
+}
+
+// compareNumbers compares numbers of any type, integers are compared exactly
+func compareNumbers(a, b reflect.Value) int {
+	switch {
+	case a.CanInt() && b.CanInt():
+		return cmp.Compare(a.Int(), b.Int())
+	case a.CanUint() && b.CanUint():
+		return cmp.Compare(a.Uint(), b.Uint())
+	case a.CanInt() && b.CanUint():
+		if a.Int() < 0 {
+			return -1
+		}
+		return cmp.Compare(uint64(a.Int()), b.Uint())
+	case a.CanUint() && b.CanInt():
+		if b.Int() < 0 {
+			return 1
+		}
+		return cmp.Compare(a.Uint(), uint64(b.Int()))
+	}
+
+	return cmp.Compare(toFloat(a), toFloat(b))
+}
+
+func toFloat(v reflect.Value) float64 {
+	switch {
+	case v.CanInt():
+		return float64(v.Int())
+	case v.CanUint():
+		return float64(v.Uint())
+	}
+
+	return v.Float()
+}
@@ -0,0 +1,115 @@
+package maps
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type point struct {
+	x, y int
+}
+
+func TestSortedKeys(t *testing.T) {
+	type testData struct {
+		m        Map
+		response []interface{}
+	}
+
+	examples := map[string]testData{
+		"empty map":       testData{m: Map{}, response: []interface{}{}},
+		"string keys":     testData{m: Map{"b": 1, "c": 2, "a": 3, "aa": 4}, response: []interface{}{"a", "aa", "b", "c"}},
+		"number keys":     testData{m: Map{10: 1, 2.5: 2, -3: 3, uint(7): 4, int8(-100): 5}, response: []interface{}{int8(-100), -3, 2.5, uint(7), 10}},
+		"equal numbers":   testData{m: Map{1: "int", 1.0: "float", uint(1): "uint"}, response: []interface{}{1.0, 1, uint(1)}},
+		"mixed key types": testData{m: Map{"a": 1, 2: 2, nil: 3, true: 4, false: 5, point{1, 2}: 6}, response: []interface{}{nil, false, true, 2, "a", point{1, 2}}},
+		"struct keys":     testData{m: Map{point{2, 1}: 1, point{1, 2}: 2}, response: []interface{}{point{1, 2}, point{2, 1}}},
+	}
+
+	for k, v := range examples {
+		for i := 0; i < 10; i++ {
+			keys := v.m.SortedKeys()
+
+			if !reflect.DeepEqual(*keys, v.response) {
+				t.Errorf("test [%v] failed on method SortedKeys with params(initialMap: %v), expected to be %v got %v", k, v.m, v.response, *keys)
+				break
+			}
+		}
+	}
+
+	m := Map{"b": 1, "C": 2, "a": 3}
+	keys := m.SortedKeys(func(a, b interface{}) int {
+		return strings.Compare(strings.ToLower(a.(string)), strings.ToLower(b.(string)))
+	})
+
+	if !reflect.DeepEqual(*keys, []interface{}{"a", "b", "C"}) {
+		t.Errorf("test [custom comparator] failed on method SortedKeys, expected to be %v got %v", []interface{}{"a", "b", "C"}, *keys)
+	}
+}
+
+func TestSortedPointerKeys(t *testing.T) {
+	one, two, three := 1, 2, 3
+	response := []interface{}{(*int)(nil), &one, &two, &three}
+
+	for i := 0; i < 10; i++ {
+		m := Map{&three: "c", &one: "a", (*int)(nil): "nil", &two: "b"}
+
+		if keys := *m.SortedKeys(); !reflect.DeepEqual(keys, response) {
+			t.Errorf("test [pointer keys] failed on method SortedKeys, expected to be %v got %v", response, keys)
+			break
+		}
+	}
+
+	if c := CompareKeys(&point{1, 2}, &point{2, 1}); c != -1 {
+		t.Errorf("test [pointers to structs] failed on method CompareKeys, expected to be -1 got %v", c)
+	}
+}
+
+func TestKeysAndValuesLineUp(t *testing.T) {
+	m := Map{}
+	for i := 0; i < 100; i++ {
+		m[i] = i * 10
+	}
+
+	keys, values := *m.Keys(), *m.Values()
+	for i := range keys {
+		if m[keys[i]] != values[i] {
+			t.Errorf("test [line up] failed on methods Keys and Values, expected value of key %v to be %v got %v", keys[i], m[keys[i]], values[i])
+		}
+
+		if keys[i] != i {
+			t.Errorf("test [line up] failed on method Keys, expected key at index %v to be %v got %v", i, i, keys[i])
+		}
+	}
+}
+
+func TestEntries(t *testing.T) {
+	m := Map{"b": 1, "a": 2, 3: nil}
+
+	response := []Entry[interface{}, interface{}]{{Key: 3, Value: nil}, {Key: "a", Value: 2}, {Key: "b", Value: 1}}
+	if entries := m.Entries(); !reflect.DeepEqual(entries, response) {
+		t.Errorf("test [mixed keys] failed on method Entries, expected to be %v got %v", response, entries)
+	}
+
+	values := m.ValuesSortedByKey()
+	if !reflect.DeepEqual(*values, []interface{}{nil, 2, 1}) {
+		t.Errorf("test [mixed keys] failed on method ValuesSortedByKey, expected to be %v got %v", []interface{}{nil, 2, 1}, *values)
+	}
+}
+
+func TestOfEntries(t *testing.T) {
+	m := Of[string, int]{"b": 1, "c": 2, "a": 3}
+
+	response := []Entry[string, int]{{Key: "a", Value: 3}, {Key: "b", Value: 1}, {Key: "c", Value: 2}}
+	if entries := m.Entries(); !reflect.DeepEqual(entries, response) {
+		t.Errorf("test [string keys] failed on method Entries, expected to be %v got %v", response, entries)
+	}
+
+	desc := func(a, b string) int { return strings.Compare(b, a) }
+	if keys := m.SortedKeys(desc); !reflect.DeepEqual(*keys, []string{"c", "b", "a"}) {
+		t.Errorf("test [descending] failed on method SortedKeys, expected to be %v got %v", []string{"c", "b", "a"}, *keys)
+	}
+
+	if values := m.ValuesSortedByKey(desc); !reflect.DeepEqual(*values, []int{2, 1, 3}) {
+		t.Errorf("test [descending] failed on method ValuesSortedByKey, expected to be %v got %v", []int{2, 1, 3}, *values)
+	}
+}
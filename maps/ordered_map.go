@@ -0,0 +1,321 @@
+package maps
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+)
+
+// OrderedMap map which keeps keys in insertion order, zero value is an empty map ready to use.
+// Set, Get, Delete and Has run in O(1)
+//
+// m := NewOrderedMap[string, int]()
+//
+// m.Set("b", 1)
+//
+// m.Set("a", 2)
+//
+// m.Keys() # => ["b", "a"]
+type OrderedMap[K comparable, V any] struct {
+	nodes      map[K]*orderedNode[K, V]
+	head, tail *orderedNode[K, V]
+}
+
+type orderedNode[K comparable, V any] struct {
+	key        K
+	value      V
+	prev, next *orderedNode[K, V]
+}
+
+// NewOrderedMap returns empty OrderedMap
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{nodes: make(map[K]*orderedNode[K, V])}
+}
+
+// Len returns number of keys
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.nodes)
+}
+
+// Set sets value of the key, new keys are added to the end, existing keys keep their position
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if n, ok := m.nodes[key]; ok {
+		n.value = value
+		return
+	}
+
+	if m.nodes == nil {
+		m.nodes = make(map[K]*orderedNode[K, V])
+	}
+
+	n := &orderedNode[K, V]{key: key, value: value}
+	m.nodes[key] = n
+	m.pushBack(n)
+}
+
+// Get returns value of the key and true, zero value and false are returned if key is missing
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if n, ok := m.nodes[key]; ok {
+		return n.value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Has checks if map contains the key
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.nodes[key]
+	return ok
+}
+
+// Delete removes the key, returns false if key is missing
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	n, ok := m.nodes[key]
+	if !ok {
+		return false
+	}
+
+	m.unlink(n)
+	delete(m.nodes, key)
+
+	return true
+}
+
+// MoveToFront moves the key to the beginning of the map, returns false if key is missing
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	n, ok := m.nodes[key]
+	if !ok {
+		return false
+	}
+
+	if n != m.head {
+		m.unlink(n)
+		n.next = m.head
+		m.head.prev = n
+		m.head = n
+	}
+
+	return true
+}
+
+// MoveToBack moves the key to the end of the map, returns false if key is missing
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	n, ok := m.nodes[key]
+	if !ok {
+		return false
+	}
+
+	if n != m.tail {
+		m.unlink(n)
+		m.pushBack(n)
+	}
+
+	return true
+}
+
+// All returns iterator over key/value pairs in insertion order
+//
+//	for k, v := range m.All() {
+//		...
+//	}
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := m.head; n != nil; n = n.next {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns map keys in insertion order
+func (m *OrderedMap[K, V]) Keys() *[]K {
+	keys := make([]K, 0, m.Len())
+
+	for n := m.head; n != nil; n = n.next {
+		keys = append(keys, n.key)
+	}
+
+	return &keys
+}
+
+// Values returns map values in insertion order of their keys
+func (m *OrderedMap[K, V]) Values() *[]V {
+	values := make([]V, 0, m.Len())
+
+	for n := m.head; n != nil; n = n.next {
+		values = append(values, n.value)
+	}
+
+	return &values
+}
+
+// Entries returns key/value pairs in insertion order
+func (m *OrderedMap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.Len())
+
+	for n := m.head; n != nil; n = n.next {
+		entries = append(entries, Entry[K, V]{Key: n.key, Value: n.value})
+	}
+
+	return entries
+}
+
+// Compact removes keys with nil values, values of types which can't be nil are kept
+func (m *OrderedMap[K, V]) Compact() {
+	for n := m.head; n != nil; {
+		next := n.next
+		if isNil(n.value) {
+			m.Delete(n.key)
+		}
+		n = next
+	}
+}
+
+// Merge merges initial map with given, values of the given map replace values of the same keys.
+// Existing keys keep their position, new keys are added to the end in order of the given map
+func (m *OrderedMap[K, V]) Merge(otherMap *OrderedMap[K, V]) {
+	if otherMap == nil {
+		return
+	}
+
+	for k, v := range otherMap.All() {
+		m.Set(k, v)
+	}
+}
+
+// MarshalJSON encodes map as JSON object keeping keys order.
+// Keys are encoded like keys of Go maps, so they have to be strings, integers or implement encoding.TextMarshaler
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for n := m.head; n != nil; n = n.next {
+		if n != m.head {
+			buf.WriteByte(',')
+		}
+
+		key, err := marshalKey(n.key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(n.value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes JSON object into map keeping keys order, decoded keys are added to the existing ones, null leaves map unchanged.
+// Nested objects are decoded as V is decoded by encoding/json, use OrderedMap as V to keep their order too
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := dec.Token()
+	if err != nil || token == nil {
+		return err
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("maps: cannot unmarshal %v into OrderedMap", token)
+	}
+
+	for dec.More() {
+		token, err = dec.Token()
+		if err != nil {
+			return err
+		}
+
+		var key K
+		if err = unmarshalKey(token.(string), &key); err != nil {
+			return err
+		}
+
+		var value V
+		if err = dec.Decode(&value); err != nil {
+			return err
+		}
+
+		m.Set(key, value)
+	}
+
+	_, err = dec.Token()
+
+	return err
+}
+
+func (m *OrderedMap[K, V]) pushBack(n *orderedNode[K, V]) {
+	n.prev, n.next = m.tail, nil
+
+	if m.tail == nil {
+		m.head = n
+	} else {
+		m.tail.next = n
+	}
+
+	m.tail = n
+}
+
+func (m *OrderedMap[K, V]) unlink(n *orderedNode[K, V]) {
+	if n.prev == nil {
+		m.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+
+	if n.next == nil {
+		m.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+
+	n.prev, n.next = nil, nil
+}
+
+// marshalKey encodes key as JSON string the same way encoding/json encodes keys of Go maps
+func marshalKey(key interface{}) ([]byte, error) {
+	v := reflect.ValueOf(key)
+
+	var name string
+	if v.Kind() == reflect.String {
+		name = v.String()
+	} else if tm, ok := key.(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		name = string(text)
+	} else if v.CanInt() {
+		name = strconv.FormatInt(v.Int(), 10)
+	} else if v.CanUint() {
+		name = strconv.FormatUint(v.Uint(), 10)
+	} else {
+		return nil, fmt.Errorf("maps: unsupported key type %T", key)
+	}
+
+	return json.Marshal(name)
+}
+
+// unmarshalKey decodes JSON object key into key, keys of not string types are decoded from unquoted key
+func unmarshalKey(raw string, key interface{}) error {
+	quoted, _ := json.Marshal(raw)
+	if err := json.Unmarshal(quoted, key); err == nil {
+		return nil
+	}
+
+	return json.Unmarshal([]byte(raw), key)
+}
@@ -0,0 +1,192 @@
+package maps
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestOrderedMapSetGetDelete(t *testing.T) {
+	var m OrderedMap[string, int]
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Set("c", 3)
+	m.Set("b", 10)
+
+	if keys := *m.Keys(); !reflect.DeepEqual(keys, []string{"b", "a", "c"}) {
+		t.Errorf("test [set] failed on method Keys, expected to be %v got %v", []string{"b", "a", "c"}, keys)
+	}
+
+	if v, ok := m.Get("b"); !ok || v != 10 {
+		t.Errorf("test [present key] failed on method Get, expected to be 10 got %v", v)
+	}
+
+	if v, ok := m.Get("x"); ok || v != 0 {
+		t.Errorf("test [missing key] failed on method Get, expected to be missing got %v", v)
+	}
+
+	if !m.Delete("a") || m.Delete("a") || m.Has("a") || m.Len() != 2 {
+		t.Errorf("test [delete] failed on method Delete, expected key a to be removed once got %v", m.Entries())
+	}
+
+	m.Set("a", 4)
+	if values := *m.Values(); !reflect.DeepEqual(values, []int{10, 3, 4}) {
+		t.Errorf("test [set after delete] failed on method Values, expected to be %v got %v", []int{10, 3, 4}, values)
+	}
+}
+
+func TestOrderedMapMove(t *testing.T) {
+	type testData struct {
+		moves    func(m *OrderedMap[int, int]) bool
+		response []int
+	}
+
+	examples := map[string]testData{
+		"to front":        testData{moves: func(m *OrderedMap[int, int]) bool { return m.MoveToFront(3) }, response: []int{3, 1, 2}},
+		"front to front":  testData{moves: func(m *OrderedMap[int, int]) bool { return m.MoveToFront(1) }, response: []int{1, 2, 3}},
+		"to back":         testData{moves: func(m *OrderedMap[int, int]) bool { return m.MoveToBack(1) }, response: []int{2, 3, 1}},
+		"middle to back":  testData{moves: func(m *OrderedMap[int, int]) bool { return m.MoveToBack(2) && m.MoveToFront(2) }, response: []int{2, 1, 3}},
+		"missing to back": testData{moves: func(m *OrderedMap[int, int]) bool { return !m.MoveToBack(4) && !m.MoveToFront(4) }, response: []int{1, 2, 3}},
+	}
+
+	for k, v := range examples {
+		m := NewOrderedMap[int, int]()
+		for i := 1; i <= 3; i++ {
+			m.Set(i, i)
+		}
+
+		if !v.moves(m) {
+			t.Errorf("test [%v] failed on method MoveToFront/MoveToBack, unexpected result", k)
+		}
+
+		if keys := *m.Keys(); !reflect.DeepEqual(keys, v.response) {
+			t.Errorf("test [%v] failed on method MoveToFront/MoveToBack, expected to be %v got %v", k, v.response, keys)
+		}
+
+		if m.Delete(v.response[2]); m.tail.key != v.response[1] || m.head.key != v.response[0] {
+			t.Errorf("test [%v] failed on method Delete, expected links to be consistent got %v", k, *m.Keys())
+		}
+	}
+}
+
+func TestOrderedMapCompactAndMerge(t *testing.T) {
+	m := NewOrderedMap[string, interface{}]()
+	m.Set("a", nil)
+	m.Set("b", 1)
+	m.Set("c", []int(nil))
+	m.Set("d", 0)
+	m.Compact()
+
+	if keys := *m.Keys(); !reflect.DeepEqual(keys, []string{"b", "d"}) {
+		t.Errorf("test [compact] failed on method Compact, expected to be %v got %v", []string{"b", "d"}, keys)
+	}
+
+	other := NewOrderedMap[string, interface{}]()
+	other.Set("e", 5)
+	other.Set("b", 2)
+	m.Merge(other)
+
+	response := []Entry[string, interface{}]{{Key: "b", Value: 2}, {Key: "d", Value: 0}, {Key: "e", Value: 5}}
+	if entries := m.Entries(); !reflect.DeepEqual(entries, response) {
+		t.Errorf("test [merge] failed on method Merge, expected to be %v got %v", response, entries)
+	}
+}
+
+func TestOrderedMapJSON(t *testing.T) {
+	m := NewOrderedMap[string, interface{}]()
+	m.Set("z", 1)
+	m.Set("a", "text")
+	m.Set("m", []int{1, 2})
+
+	data, err := json.Marshal(m)
+	if err != nil || string(data) != `{"z":1,"a":"text","m":[1,2]}` {
+		t.Errorf("test [marshal] failed on method MarshalJSON, expected to be %v got %s and error %v", `{"z":1,"a":"text","m":[1,2]}`, data, err)
+	}
+
+	nested := NewOrderedMap[string, *OrderedMap[string, int]]()
+	if err = json.Unmarshal([]byte(`{"y": {"b": 1, "a": 2}, "x": {}}`), nested); err != nil {
+		t.Errorf("test [unmarshal] failed on method UnmarshalJSON, unexpected error %v", err)
+	}
+
+	if data, _ = json.Marshal(nested); string(data) != `{"y":{"b":1,"a":2},"x":{}}` {
+		t.Errorf("test [round trip] failed on method UnmarshalJSON, expected to be %v got %s", `{"y":{"b":1,"a":2},"x":{}}`, data)
+	}
+
+	ints := NewOrderedMap[int, bool]()
+	ints.Set(10, true)
+	ints.Set(-2, false)
+	if data, err = json.Marshal(ints); err != nil || string(data) != `{"10":true,"-2":false}` {
+		t.Errorf("test [int keys] failed on method MarshalJSON, expected to be %v got %s and error %v", `{"10":true,"-2":false}`, data, err)
+	}
+
+	decoded := NewOrderedMap[int, bool]()
+	if err = json.Unmarshal(data, decoded); err != nil || !reflect.DeepEqual(*decoded.Keys(), []int{10, -2}) {
+		t.Errorf("test [int keys] failed on method UnmarshalJSON, expected keys to be [10 -2] got %v and error %v", *decoded.Keys(), err)
+	}
+
+	if err = json.Unmarshal([]byte(`[1, 2]`), decoded); err == nil {
+		t.Errorf("test [not an object] failed on method UnmarshalJSON, expected error got nil")
+	}
+
+	floats := NewOrderedMap[float64, int]()
+	floats.Set(1.5, 1)
+	if _, err = json.Marshal(floats); err == nil {
+		t.Errorf("test [float keys] failed on method MarshalJSON, expected error got nil")
+	}
+}
+
+type fieldID int
+
+func TestOrderedMapJSONByValue(t *testing.T) {
+	inner := NewOrderedMap[string, int]()
+	inner.Set("b", 1)
+	inner.Set("a", 2)
+
+	nested := NewOrderedMap[string, OrderedMap[string, int]]()
+	nested.Set("x", *inner)
+
+	if data, err := json.Marshal(*nested); err != nil || string(data) != `{"x":{"b":1,"a":2}}` {
+		t.Errorf("test [nested value] failed on method MarshalJSON, expected to be %v got %s and error %v", `{"x":{"b":1,"a":2}}`, data, err)
+	}
+
+	decoded := NewOrderedMap[string, OrderedMap[string, int]]()
+	if err := json.Unmarshal([]byte(`{"x":{"b":1,"a":2}}`), decoded); err != nil {
+		t.Errorf("test [nested value] failed on method UnmarshalJSON, unexpected error %v", err)
+	}
+
+	if data, _ := json.Marshal(decoded); string(data) != `{"x":{"b":1,"a":2}}` {
+		t.Errorf("test [nested value] failed on method UnmarshalJSON, expected to be %v got %s", `{"x":{"b":1,"a":2}}`, data)
+	}
+
+	type document struct {
+		Fields OrderedMap[fieldID, string]
+	}
+
+	doc := document{}
+	doc.Fields.Set(2, "b")
+	doc.Fields.Set(1, "a")
+
+	data, err := json.Marshal(doc)
+	if err != nil || string(data) != `{"Fields":{"2":"b","1":"a"}}` {
+		t.Errorf("test [struct field] failed on method MarshalJSON, expected to be %v got %s and error %v", `{"Fields":{"2":"b","1":"a"}}`, data, err)
+	}
+
+	var decodedDoc document
+	if err = json.Unmarshal(data, &decodedDoc); err != nil || !reflect.DeepEqual(*decodedDoc.Fields.Keys(), []fieldID{2, 1}) {
+		t.Errorf("test [struct field] failed on method UnmarshalJSON, expected keys to be [2 1] got %v and error %v", *decodedDoc.Fields.Keys(), err)
+	}
+
+	if err = json.Unmarshal([]byte(`{"Fields":null}`), &decodedDoc); err != nil || decodedDoc.Fields.Len() != 2 {
+		t.Errorf("test [null] failed on method UnmarshalJSON, expected map to stay unchanged got %v and error %v", decodedDoc.Fields.Entries(), err)
+	}
+}
+
+func TestOrderedMapMergeNil(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Merge(nil)
+
+	if m.Len() != 1 {
+		t.Errorf("test [nil map] failed on method Merge, expected map to stay unchanged got %v", m.Entries())
+	}
+}
@@ -0,0 +1,397 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Path list of keys and slice indexes leading to nested value.
+// Slices are indexed by int or by string of digits without leading zeros (like in JSON Pointer), negative int indexes count from the end
+type Path []interface{}
+
+// ErrInvalidPath is returned when path can't be parsed or doesn't lead through maps and slices
+var ErrInvalidPath = errors.New("invalid path")
+
+// KeyError is returned by FetchOrError when key is missing
+type KeyError struct {
+	Key interface{}
+}
+
+func (e *KeyError) Error() string {
+	return fmt.Sprintf("key not found: %v", e.Key)
+}
+
+// ParsePath parses dotted path or JSON Pointer (RFC 6901) into Path
+//
+// ParsePath("a.b[2].c") # => Path{"a", "b", 2, "c"}
+//
+// ParsePath("/a/b/2/c") # => Path{"a", "b", "2", "c"}
+func ParsePath(path string) (Path, error) {
+	if path == "" {
+		return Path{}, nil
+	}
+
+	if strings.HasPrefix(path, "/") {
+		return parsePointer(path), nil
+	}
+
+	return parseDotted(path)
+}
+
+// Dig returns nested value by the given keys, it walks nested Map, map[string]interface{}, map[interface{}]interface{}
+// and slices. nil is returned if any key is missing
+//
+// m := Map{"users": []interface{}{map[string]interface{}{"name": "bob"}}}
+//
+// m.Dig("users", 0, "name") # => "bob"
+func (m *Map) Dig(keys ...interface{}) interface{} {
+	var value interface{} = *m
+
+	for _, k := range keys {
+		next, ok := child(value, k)
+		if !ok {
+			return nil
+		}
+		value = next
+	}
+
+	return value
+}
+
+// DigPath returns nested value by dotted path or JSON Pointer, nil is returned if any key is missing.
+// ErrInvalidPath is returned if path can't be parsed
+//
+// m.DigPath("users[0].name") # => "bob"
+//
+// m.DigPath("/users/0/name") # => "bob"
+func (m *Map) DigPath(path string) (interface{}, error) {
+	keys, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Dig(keys...), nil
+}
+
+// Fetch returns value of the key, defaultValue is returned if key is missing
+func (m *Map) Fetch(key, defaultValue interface{}) interface{} {
+	if v, ok := (*m)[key]; ok {
+		return v
+	}
+
+	return defaultValue
+}
+
+// FetchOrError returns value of the key, KeyError is returned if key is missing
+func (m *Map) FetchOrError(key interface{}) (interface{}, error) {
+	if v, ok := (*m)[key]; ok {
+		return v, nil
+	}
+
+	return nil, &KeyError{Key: key}
+}
+
+// SetIn sets nested value by the given path, missing intermediate keys and nil values are replaced with Map (map[string]interface{} inside decoded JSON).
+// ErrInvalidPath is returned if path leads through value which is not a map or slice, or slice index is out of range
+//
+// m := Map{}
+//
+// m.SetIn(Path{"db", "host"}, "localhost") # => Map{"db": Map{"host": "localhost"}}
+func (m *Map) SetIn(path Path, value interface{}) error {
+	if len(path) == 0 {
+		return fmt.Errorf("%w: empty path", ErrInvalidPath)
+	}
+
+	if *m == nil {
+		*m = Map{}
+	}
+
+	_, err := setIn(*m, path, value)
+
+	return err
+}
+
+// DeleteIn deletes nested value by the given path, elements of slices are removed. Returns false if path is missing
+func (m *Map) DeleteIn(path Path) bool {
+	if len(path) == 0 {
+		return false
+	}
+
+	_, ok := deleteIn(*m, path)
+
+	return ok
+}
+
+// SetInPath sets nested value by dotted path or JSON Pointer like SetIn does
+//
+// m.SetInPath("db.hosts[0]", "localhost")
+func (m *Map) SetInPath(path string, value interface{}) error {
+	keys, err := ParsePath(path)
+	if err != nil {
+		return err
+	}
+
+	return m.SetIn(keys, value)
+}
+
+// DeleteInPath deletes nested value by dotted path or JSON Pointer like DeleteIn does, ErrInvalidPath is returned if path can't be parsed
+func (m *Map) DeleteInPath(path string) (bool, error) {
+	keys, err := ParsePath(path)
+	if err != nil {
+		return false, err
+	}
+
+	return m.DeleteIn(keys), nil
+}
+
+func setIn(container interface{}, path Path, value interface{}) (interface{}, error) {
+	if len(path) > 1 {
+		next, ok := child(container, path[0])
+		if !ok && isSlice(container) {
+			return nil, fmt.Errorf("%w: index %v is out of range", ErrInvalidPath, path[0])
+		}
+
+		if next == nil {
+			next = newNested(container)
+		}
+
+		var err error
+		if value, err = setIn(next, path[1:], value); err != nil {
+			return nil, err
+		}
+	}
+
+	return setChild(container, path[0], value)
+}
+
+func deleteIn(container interface{}, path Path) (interface{}, bool) {
+	if len(path) == 1 {
+		return deleteChild(container, path[0])
+	}
+
+	next, ok := child(container, path[0])
+	if !ok {
+		return container, false
+	}
+
+	if next, ok = deleteIn(next, path[1:]); !ok {
+		return container, false
+	}
+
+	// slices are replaced after their elements are removed
+	container, err := setChild(container, path[0], next)
+
+	return container, err == nil
+}
+
+// child returns value of map key or slice index
+func child(container, key interface{}) (interface{}, bool) {
+	switch c := container.(type) {
+	case Map:
+		v, ok := c[key]
+		return v, ok
+	case map[interface{}]interface{}:
+		v, ok := c[key]
+		return v, ok
+	case map[string]interface{}:
+		k, ok := key.(string)
+		if !ok {
+			return nil, false
+		}
+		v, ok := c[k]
+		return v, ok
+	}
+
+	if !isSlice(container) {
+		return nil, false
+	}
+
+	slice := reflect.ValueOf(container)
+	i, ok := sliceIndex(key, slice.Len())
+	if !ok {
+		return nil, false
+	}
+
+	return slice.Index(i).Interface(), true
+}
+
+// setChild sets value of map key or slice index, container with the value is returned
+func setChild(container, key, value interface{}) (interface{}, error) {
+	switch c := container.(type) {
+	case Map:
+		c[key] = value
+		return c, nil
+	case map[interface{}]interface{}:
+		c[key] = value
+		return c, nil
+	case map[string]interface{}:
+		k, ok := key.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: key %v of map[string]interface{} is %T", ErrInvalidPath, key, key)
+		}
+		c[k] = value
+		return c, nil
+	}
+
+	if !isSlice(container) {
+		return nil, fmt.Errorf("%w: %T is not a map or slice", ErrInvalidPath, container)
+	}
+
+	slice := reflect.ValueOf(container)
+	i, ok := sliceIndex(key, slice.Len())
+	if !ok {
+		return nil, fmt.Errorf("%w: index %v is out of range", ErrInvalidPath, key)
+	}
+
+	el := reflect.ValueOf(value)
+	if !el.IsValid() {
+		el = reflect.Zero(slice.Type().Elem())
+	}
+
+	if !el.Type().AssignableTo(slice.Type().Elem()) {
+		return nil, fmt.Errorf("%w: %T can't be stored in %T", ErrInvalidPath, value, container)
+	}
+
+	slice.Index(i).Set(el)
+
+	return container, nil
+}
+
+// deleteChild deletes map key or removes slice element, container without the value is returned
+func deleteChild(container, key interface{}) (interface{}, bool) {
+	if _, ok := child(container, key); !ok {
+		return container, false
+	}
+
+	switch c := container.(type) {
+	case Map:
+		delete(c, key)
+		return c, true
+	case map[interface{}]interface{}:
+		delete(c, key)
+		return c, true
+	case map[string]interface{}:
+		delete(c, key.(string))
+		return c, true
+	}
+
+	slice := reflect.ValueOf(container)
+	i, _ := sliceIndex(key, slice.Len())
+
+	removed := reflect.MakeSlice(slice.Type(), 0, slice.Len()-1)
+	removed = reflect.AppendSlice(removed, slice.Slice(0, i))
+	removed = reflect.AppendSlice(removed, slice.Slice(i+1, slice.Len()))
+
+	return removed.Interface(), true
+}
+
+// sliceIndex converts int or string of digits to index of slice of the given length, only int index can be negative
+func sliceIndex(key interface{}, length int) (int, bool) {
+	var i int
+	switch k := key.(type) {
+	case int:
+		i = k
+		if i < 0 {
+			i += length
+		}
+	case string:
+		if !isArrayIndex(k) {
+			return 0, false
+		}
+
+		n, err := strconv.Atoi(k)
+		if err != nil {
+			return 0, false
+		}
+		i = n
+	default:
+		return 0, false
+	}
+
+	return i, i >= 0 && i < length
+}
+
+// isArrayIndex checks if key is array index of JSON Pointer: 0 or digits without leading zero
+func isArrayIndex(key string) bool {
+	if key == "" || (key[0] == '0' && len(key) > 1) {
+		return false
+	}
+
+	for _, r := range key {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newNested returns empty map for missing intermediate key or nil value,
+// maps and slices decoded from JSON get map[string]interface{}
+func newNested(container interface{}) interface{} {
+	switch container.(type) {
+	case map[string]interface{}, []interface{}:
+		return map[string]interface{}{}
+	}
+
+	return Map{}
+}
+
+func isSlice(value interface{}) bool {
+	return value != nil && reflect.TypeOf(value).Kind() == reflect.Slice
+}
+
+// parsePointer parses JSON Pointer, ~1 and ~0 are unescaped to / and ~
+func parsePointer(path string) Path {
+	tokens := strings.Split(path[1:], "/")
+	keys := make(Path, 0, len(tokens))
+
+	for _, t := range tokens {
+		t = strings.ReplaceAll(t, "~1", "/")
+		t = strings.ReplaceAll(t, "~0", "~")
+		keys = append(keys, t)
+	}
+
+	return keys
+}
+
+// parseDotted parses path like a.b[2].c, indexes in brackets are parsed as int
+func parseDotted(path string) (Path, error) {
+	keys := Path{}
+
+	for _, segment := range strings.Split(path, ".") {
+		name, rest, hasIndex := strings.Cut(segment, "[")
+		switch {
+		case name == "" && !hasIndex:
+			return nil, fmt.Errorf("%w: empty key in %q", ErrInvalidPath, path)
+		case strings.Contains(name, "]"):
+			return nil, fmt.Errorf("%w: unopened bracket in %q", ErrInvalidPath, path)
+		}
+
+		if name != "" {
+			keys = append(keys, name)
+		}
+
+		for hasIndex {
+			index, tail, ok := strings.Cut(rest, "]")
+			if !ok {
+				return nil, fmt.Errorf("%w: unclosed bracket in %q", ErrInvalidPath, path)
+			}
+
+			i, err := strconv.Atoi(index)
+			if err != nil {
+				return nil, fmt.Errorf("%w: index %q in %q is not a number", ErrInvalidPath, index, path)
+			}
+			keys = append(keys, i)
+
+			if rest, hasIndex = strings.CutPrefix(tail, "["); !hasIndex && tail != "" {
+				return nil, fmt.Errorf("%w: unexpected %q in %q", ErrInvalidPath, tail, path)
+			}
+		}
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,233 @@
+package maps
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func decodedJSON() Map {
+	var m map[string]interface{}
+	json.Unmarshal([]byte(`{"users": [{"name": "bob", "tags": ["a", "b"]}, {"name": "alice"}], "count": 2}`), &m)
+
+	return Map(toInterfaceMap(m))
+}
+
+func toInterfaceMap(m map[string]interface{}) map[interface{}]interface{} {
+	newMap := make(map[interface{}]interface{}, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+
+	return newMap
+}
+
+func TestParsePath(t *testing.T) {
+	type testData struct {
+		path     string
+		response Path
+		err      error
+	}
+
+	examples := map[string]testData{
+		"empty path":       testData{path: "", response: Path{}},
+		"dotted path":      testData{path: "a.b[2].c", response: Path{"a", "b", 2, "c"}},
+		"nested indexes":   testData{path: "a[0][-1]", response: Path{"a", 0, -1}},
+		"leading index":    testData{path: "[1].a", response: Path{1, "a"}},
+		"json pointer":     testData{path: "/a/b/2/c", response: Path{"a", "b", "2", "c"}},
+		"escaped pointer":  testData{path: "/a~1b/c~0d", response: Path{"a/b", "c~d"}},
+		"empty key":        testData{path: "a..b", err: ErrInvalidPath},
+		"unclosed bracket": testData{path: "a[1", err: ErrInvalidPath},
+		"not a number":     testData{path: "a[x]", err: ErrInvalidPath},
+		"text after index": testData{path: "a[1]b", err: ErrInvalidPath},
+	}
+
+	for k, v := range examples {
+		resp, err := ParsePath(v.path)
+		if !errors.Is(err, v.err) || (v.err == nil && !reflect.DeepEqual(resp, v.response)) {
+			t.Errorf("test [%v] failed on method ParsePath with params(path: %v), expected to be %v and error %v got %v and error %v", k, v.path, v.response, v.err, resp, err)
+		}
+	}
+}
+
+func TestDig(t *testing.T) {
+	type testData struct {
+		path     string
+		response interface{}
+	}
+
+	examples := map[string]testData{
+		"top level key":      testData{path: "count", response: 2.0},
+		"nested key":         testData{path: "users[0].name", response: "bob"},
+		"negative index":     testData{path: "users[-1].name", response: "alice"},
+		"json pointer":       testData{path: "/users/0/tags/1", response: "b"},
+		"missing key":        testData{path: "users[1].tags", response: nil},
+		"index out of range": testData{path: "users[2].name", response: nil},
+		"not a container":    testData{path: "count.value", response: nil},
+	}
+
+	m := decodedJSON()
+	for k, v := range examples {
+		path, _ := ParsePath(v.path)
+
+		if resp := m.Dig(path...); !reflect.DeepEqual(resp, v.response) {
+			t.Errorf("test [%v] failed on method Dig with params(path: %v), expected to be %v got %v", k, v.path, v.response, resp)
+		}
+	}
+
+	if resp := m.Dig(); !reflect.DeepEqual(resp, m) {
+		t.Errorf("test [no keys] failed on method Dig, expected to be %v got %v", m, resp)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	m := Map{"a": 1, "b": nil}
+
+	if resp := m.Fetch("b", 2); resp != nil {
+		t.Errorf("test [nil value] failed on method Fetch, expected to be nil got %v", resp)
+	}
+
+	if resp := m.Fetch("c", 3); resp != 3 {
+		t.Errorf("test [missing key] failed on method Fetch, expected to be 3 got %v", resp)
+	}
+
+	if resp, err := m.FetchOrError("a"); err != nil || resp != 1 {
+		t.Errorf("test [present key] failed on method FetchOrError, expected to be 1 got %v and error %v", resp, err)
+	}
+
+	var keyErr *KeyError
+	if _, err := m.FetchOrError("c"); !errors.As(err, &keyErr) || keyErr.Key != "c" {
+		t.Errorf("test [missing key] failed on method FetchOrError, expected KeyError for key c got %v", err)
+	}
+}
+
+func TestSetIn(t *testing.T) {
+	m := decodedJSON()
+
+	if err := m.SetIn(Path{"db", "host"}, "localhost"); err != nil || !reflect.DeepEqual(m["db"], Map{"host": "localhost"}) {
+		t.Errorf("test [new keys] failed on method SetIn, expected to be %v got %v and error %v", Map{"host": "localhost"}, m["db"], err)
+	}
+
+	path, _ := ParsePath("users[1].address.city")
+	if err := m.SetIn(path, "Kyiv"); err != nil || m.Dig(path...) != "Kyiv" {
+		t.Errorf("test [decoded json] failed on method SetIn, expected to be Kyiv got %v and error %v", m.Dig(path...), err)
+	}
+
+	if _, ok := m.Dig("users", 1, "address").(map[string]interface{}); !ok {
+		t.Errorf("test [decoded json] failed on method SetIn, expected intermediate map to be map[string]interface{} got %T", m.Dig("users", 1, "address"))
+	}
+
+	path, _ = ParsePath("/users/0/tags/0")
+	if err := m.SetIn(path, "z"); err != nil || !reflect.DeepEqual(m.Dig("users", 0, "tags"), []interface{}{"z", "b"}) {
+		t.Errorf("test [slice element] failed on method SetIn, expected to be [z b] got %v and error %v", m.Dig("users", 0, "tags"), err)
+	}
+
+	errorPaths := map[string]Path{
+		"empty path":         Path{},
+		"index out of range": Path{"users", 5, "name"},
+		"not a container":    Path{"count", "value"},
+		"int key":            Path{"users", 0, 1},
+	}
+
+	for k, v := range errorPaths {
+		if err := m.SetIn(v, 1); !errors.Is(err, ErrInvalidPath) {
+			t.Errorf("test [%v] failed on method SetIn with params(path: %v), expected error to be %v got %v", k, v, ErrInvalidPath, err)
+		}
+	}
+
+	ints := Map{"a": []int{1, 2}}
+	if err := ints.SetIn(Path{"a", 0}, "x"); !errors.Is(err, ErrInvalidPath) {
+		t.Errorf("test [wrong element type] failed on method SetIn, expected error to be %v got %v", ErrInvalidPath, err)
+	}
+
+	nils := Map{"arr": []interface{}{nil}}
+	if err := nils.SetIn(Path{"arr", 0, "x"}, 1); err != nil || !reflect.DeepEqual(nils.Dig("arr", 0), map[string]interface{}{"x": 1}) {
+		t.Errorf("test [nil element] failed on method SetIn, expected to be map[x:1] got %v and error %v", nils.Dig("arr", 0), err)
+	}
+
+	var empty Map
+	if err := empty.SetIn(Path{"a"}, 1); err != nil || empty["a"] != 1 {
+		t.Errorf("test [nil map] failed on method SetIn, expected to be map[a:1] got %v and error %v", empty, err)
+	}
+}
+
+func TestDeleteIn(t *testing.T) {
+	type testData struct {
+		path     string
+		deleted  bool
+		response interface{}
+	}
+
+	examples := map[string]testData{
+		"top level key":  testData{path: "count", deleted: true, response: nil},
+		"nested key":     testData{path: "users[0].name", deleted: true, response: map[string]interface{}{"tags": []interface{}{"a", "b"}}},
+		"slice element":  testData{path: "/users/0/tags/0", deleted: true, response: map[string]interface{}{"name": "bob", "tags": []interface{}{"b"}}},
+		"last element":   testData{path: "users[-1]", deleted: true, response: map[string]interface{}{"name": "bob", "tags": []interface{}{"a", "b"}}},
+		"missing key":    testData{path: "users[0].age", deleted: false, response: map[string]interface{}{"name": "bob", "tags": []interface{}{"a", "b"}}},
+		"missing parent": testData{path: "db.host", deleted: false, response: map[string]interface{}{"name": "bob", "tags": []interface{}{"a", "b"}}},
+	}
+
+	for k, v := range examples {
+		m := decodedJSON()
+		path, _ := ParsePath(v.path)
+
+		if deleted := m.DeleteIn(path); deleted != v.deleted {
+			t.Errorf("test [%v] failed on method DeleteIn with params(path: %v), expected to be %v got %v", k, v.path, v.deleted, deleted)
+		}
+
+		response := m.Dig("users", 0)
+		if v.path == "count" {
+			response = m.Dig("count")
+		}
+
+		if !reflect.DeepEqual(response, v.response) {
+			t.Errorf("test [%v] failed on method DeleteIn with params(path: %v), expected to be %v got %v", k, v.path, v.response, response)
+		}
+	}
+
+	if m := decodedJSON(); m.DeleteIn(Path{}) || len(m) != 2 {
+		t.Errorf("test [empty path] failed on method DeleteIn, expected map to stay unchanged got %v", m)
+	}
+}
+
+func TestDeleteInSliceLength(t *testing.T) {
+	m := decodedJSON()
+	m.DeleteIn(Path{"users", -1})
+
+	if users := m.Dig("users").([]interface{}); len(users) != 1 {
+		t.Errorf("test [last element] failed on method DeleteIn, expected users to have 1 element got %v", users)
+	}
+}
+
+func TestStringPaths(t *testing.T) {
+	m := decodedJSON()
+
+	if resp, err := m.DigPath("users[1].name"); err != nil || resp != "alice" {
+		t.Errorf("test [dotted path] failed on method DigPath, expected to be alice got %v and error %v", resp, err)
+	}
+
+	if resp, err := m.DigPath("/users/0/tags/1"); err != nil || resp != "b" {
+		t.Errorf("test [json pointer] failed on method DigPath, expected to be b got %v and error %v", resp, err)
+	}
+
+	if err := m.SetInPath("/users/1/age", 30); err != nil || m.Dig("users", 1, "age") != 30 {
+		t.Errorf("test [json pointer] failed on method SetInPath, expected to be 30 got %v and error %v", m.Dig("users", 1, "age"), err)
+	}
+
+	if deleted, err := m.DeleteInPath("users[0].tags[0]"); err != nil || !deleted || !reflect.DeepEqual(m.Dig("users", 0, "tags"), []interface{}{"b"}) {
+		t.Errorf("test [dotted path] failed on method DeleteInPath, expected to be [b] got %v and error %v", m.Dig("users", 0, "tags"), err)
+	}
+
+	if _, err := m.DigPath("users]0"); !errors.Is(err, ErrInvalidPath) {
+		t.Errorf("test [invalid path] failed on method DigPath, expected error to be %v got %v", ErrInvalidPath, err)
+	}
+
+	if err := m.SetInPath("a..b", 1); !errors.Is(err, ErrInvalidPath) {
+		t.Errorf("test [invalid path] failed on method SetInPath, expected error to be %v got %v", ErrInvalidPath, err)
+	}
+
+	if deleted, err := m.DeleteInPath("a[x]"); deleted || !errors.Is(err, ErrInvalidPath) {
+		t.Errorf("test [invalid path] failed on method DeleteInPath, expected error to be %v got %v", ErrInvalidPath, err)
+	}
+}
@@ -0,0 +1,85 @@
+package strings
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// graphemes splits string into user-perceived characters. It is a simplified version of unicode text segmentation
+// rules (UAX #29): CR LF pairs, combining marks, joiners, variation selectors, emoji modifiers, regional indicator pairs
+// and hangul jamo sequences are kept in one cluster
+func graphemes(str string) []string {
+	clusters := make([]string, 0, utf8.RuneCountInString(str))
+
+	start := 0
+	var prev rune
+	regionalIndicators := 0
+
+	for i, r := range str {
+		if i > 0 && !continuesCluster(prev, r, regionalIndicators) {
+			clusters = append(clusters, str[start:i])
+			start = i
+			regionalIndicators = 0
+		}
+
+		if isRegionalIndicator(r) {
+			regionalIndicators++
+		}
+		prev = r
+	}
+
+	if start < len(str) {
+		clusters = append(clusters, str[start:])
+	}
+
+	return clusters
+}
+
+// continuesCluster checks if rune r belongs to the same cluster as preceding rune prev
+func continuesCluster(prev, r rune, regionalIndicators int) bool {
+	switch {
+	case prev == '\r' && r == '\n':
+		return true
+	case prev == zeroWidthJoiner:
+		return true
+	case isRegionalIndicator(prev) && isRegionalIndicator(r):
+		return regionalIndicators%2 == 1
+	case isHangulJamoTail(r) && isHangulJamo(prev):
+		return true
+	}
+
+	return isExtend(r)
+}
+
+const zeroWidthJoiner = '\u200d'
+
+func isExtend(r rune) bool {
+	switch {
+	case r == zeroWidthJoiner:
+		return true
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc):
+		return true
+	case r >= 0xFE00 && r <= 0xFE0F, r >= 0xE0100 && r <= 0xE01EF: // variation selectors
+		return true
+	case r >= 0x1F3FB && r <= 0x1F3FF: // emoji skin tone modifiers
+		return true
+	case r >= 0xE0020 && r <= 0xE007F: // tags used in subdivision flags
+		return true
+	}
+
+	return false
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// isHangulJamo checks if rune is a hangul jamo or a precomposed syllable which may be followed by jamo
+func isHangulJamo(r rune) bool {
+	return (r >= 0x1100 && r <= 0x11FF) || (r >= 0xAC00 && r <= 0xD7A3)
+}
+
+// isHangulJamoTail checks if rune is a medial vowel or a final consonant jamo
+func isHangulJamoTail(r rune) bool {
+	return r >= 0x1160 && r <= 0x11FF
+}
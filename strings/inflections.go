@@ -1,83 +1,439 @@
 package strings
 
+import (
+	"regexp"
+	"sort"
+	strings2 "strings"
+	"sync"
+	"unicode"
+)
+
 type inflection struct {
 	rule        string
 	replacement string
 }
 
-type inflections struct{}
+type compiledInflection struct {
+	rule        *regexp.Regexp
+	replacement string
+}
+
+// Inflector holds the inflection rules of a single locale. Rules are applied in reverse order,
+// so the last defined rule has the highest priority (same as in rails)
+type Inflector struct {
+	mu           sync.RWMutex
+	plurals      []compiledInflection
+	singulars    []compiledInflection
+	uncountables []string
+	acronyms     map[string]string
+}
+
+var (
+	registryMu   sync.RWMutex
+	registry     = map[string]*Inflector{"en": newEnglishInflector()}
+	activeLocale = "en"
+)
+
+// Inflections returns inflector registered for the given locale, new empty inflector is registered if there is no such locale yet
+//
+// Inflections("en").Irregular("datum", "data")
+//
+// Inflections("es").Plural(`(?i)([aeiou])$`, "${1}s")
+func Inflections(locale string) *Inflector {
+	registryMu.RLock()
+	i, ok := registry[locale]
+	registryMu.RUnlock()
+
+	if ok {
+		return i
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if i, ok = registry[locale]; !ok {
+		i = NewInflector()
+		registry[locale] = i
+	}
+
+	return i
+}
+
+// SetLocale sets locale which inflector is used by Pluralize, Singularize, Camelize and Humanize
+func SetLocale(locale string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	activeLocale = locale
+}
+
+// Locale returns locale which is currently used by package level functions, "en" by default
+func Locale() string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	return activeLocale
+}
+
+func activeInflector() *Inflector {
+	return Inflections(Locale())
+}
+
+// NewInflector returns inflector without any rules
+func NewInflector() *Inflector {
+	return &Inflector{acronyms: make(map[string]string)}
+}
+
+// newEnglishInflector returns inflector with english rules, same as rails have by default
+func newEnglishInflector() *Inflector {
+	i := NewInflector()
+
+	for _, e := range defaultPlurals() {
+		i.mustPlural(e.rule, e.replacement)
+	}
+
+	for _, e := range defaultSingulars() {
+		i.mustSingular(e.rule, e.replacement)
+	}
+
+	for _, e := range defaultIrregulars() {
+		i.Irregular(e.rule, e.replacement)
+	}
 
-func (i *inflections) plural() []inflection {
+	i.Uncountable(defaultUncountables()...)
+
+	for _, e := range defaultAcronyms() {
+		i.Acronym(e)
+	}
+
+	return i
+}
+
+func defaultPlurals() []inflection {
 	return []inflection{
 		inflection{rule: `$`, replacement: "s"},
 		inflection{rule: `(?i)s$`, replacement: "s"},
-		inflection{rule: `(?i)^(ax|test)is$`, replacement: "\\1es"},
-		inflection{rule: `(?i)(octop|vir)us$`, replacement: "\\1i"},
-		inflection{rule: `(?i)(octop|vir)i$`, replacement: "\\1i"},
-		inflection{rule: `(?i)(alias|status)$`, replacement: "\\1es"},
-		inflection{rule: `(?i)(bu)s$`, replacement: "\\1ses"},
-		inflection{rule: `(?i)(buffal|tomat)o$`, replacement: "\\1oes"},
-		inflection{rule: `(?i)([ti])um$`, replacement: "\\1a"},
-		inflection{rule: `(?i)([ti])a$`, replacement: "\\1a"},
+		inflection{rule: `(?i)^(ax|test)is$`, replacement: "${1}es"},
+		inflection{rule: `(?i)(octop|vir)us$`, replacement: "${1}i"},
+		inflection{rule: `(?i)(octop|vir)i$`, replacement: "${1}i"},
+		inflection{rule: `(?i)(alias|status)$`, replacement: "${1}es"},
+		inflection{rule: `(?i)(bu)s$`, replacement: "${1}ses"},
+		inflection{rule: `(?i)(buffal|tomat)o$`, replacement: "${1}oes"},
+		inflection{rule: `(?i)([ti])um$`, replacement: "${1}a"},
+		inflection{rule: `(?i)([ti])a$`, replacement: "${1}a"},
 		inflection{rule: `(?i)sis$`, replacement: "ses"},
-		inflection{rule: `(?i)(?:([^f])fe|([lr])f)$`, replacement: "\\1\\2ves"},
-		inflection{rule: `(?i)(hive)$`, replacement: "\\1s"},
-		inflection{rule: `(?i)([^aeiouy]|qu)y$`, replacement: "\\1ies"},
-		inflection{rule: `(?i)(x|ch|ss|sh)$`, replacement: "\\1es"},
-		inflection{rule: `(?i)(matr|vert|ind)(?:ix|ex)$`, replacement: "\\1ices"},
-		inflection{rule: `(?i)^(m|l)ouse$`, replacement: "\\1ice"},
-		inflection{rule: `(?i)^(m|l)ice$`, replacement: "\\1ice"},
-		inflection{rule: `(?i)^(ox)$`, replacement: "\\1en"},
-		inflection{rule: `(?i)^(oxen)$`, replacement: "\\1"},
-		inflection{rule: `(?i)(quiz)$`, replacement: "\\1zes"},
+		inflection{rule: `(?i)(?:([^f])fe|([lr])f)$`, replacement: "${1}${2}ves"},
+		inflection{rule: `(?i)(hive)$`, replacement: "${1}s"},
+		inflection{rule: `(?i)([^aeiouy]|qu)y$`, replacement: "${1}ies"},
+		inflection{rule: `(?i)(x|ch|ss|sh)$`, replacement: "${1}es"},
+		inflection{rule: `(?i)(matr|vert|ind)(?:ix|ex)$`, replacement: "${1}ices"},
+		inflection{rule: `(?i)^(m|l)ouse$`, replacement: "${1}ice"},
+		inflection{rule: `(?i)^(m|l)ice$`, replacement: "${1}ice"},
+		inflection{rule: `(?i)^(ox)$`, replacement: "${1}en"},
+		inflection{rule: `(?i)^(oxen)$`, replacement: "${1}"},
+		inflection{rule: `(?i)(quiz)$`, replacement: "${1}zes"},
 	}
 }
 
-func (i *inflections) singular() []inflection {
-	return []inflection{}
+func defaultSingulars() []inflection {
+	return []inflection{
+		inflection{rule: `(?i)s$`, replacement: ""},
+		inflection{rule: `(?i)(ss)$`, replacement: "${1}"},
+		inflection{rule: `(?i)(n)ews$`, replacement: "${1}ews"},
+		inflection{rule: `(?i)([ti])a$`, replacement: "${1}um"},
+		inflection{rule: `(?i)((a)naly|(b)a|(d)iagno|(p)arenthe|(p)rogno|(s)ynop|(t)he)(sis|ses)$`, replacement: "${1}sis"},
+		inflection{rule: `(?i)(^analy)(sis|ses)$`, replacement: "${1}sis"},
+		inflection{rule: `(?i)([^f])ves$`, replacement: "${1}fe"},
+		inflection{rule: `(?i)(hive)s$`, replacement: "${1}"},
+		inflection{rule: `(?i)(tive)s$`, replacement: "${1}"},
+		inflection{rule: `(?i)([lr])ves$`, replacement: "${1}f"},
+		inflection{rule: `(?i)([^aeiouy]|qu)ies$`, replacement: "${1}y"},
+		inflection{rule: `(?i)(s)eries$`, replacement: "${1}eries"},
+		inflection{rule: `(?i)(m)ovies$`, replacement: "${1}ovie"},
+		inflection{rule: `(?i)(x|ch|ss|sh)es$`, replacement: "${1}"},
+		inflection{rule: `(?i)^(m|l)ice$`, replacement: "${1}ouse"},
+		inflection{rule: `(?i)(bus)(es)?$`, replacement: "${1}"},
+		inflection{rule: `(?i)(o)es$`, replacement: "${1}"},
+		inflection{rule: `(?i)(shoe)s$`, replacement: "${1}"},
+		inflection{rule: `(?i)(cris|test)(is|es)$`, replacement: "${1}is"},
+		inflection{rule: `(?i)^(a)x[ie]s$`, replacement: "${1}xis"},
+		inflection{rule: `(?i)(octop|vir)(us|i)$`, replacement: "${1}us"},
+		inflection{rule: `(?i)(alias|status)(es)?$`, replacement: "${1}"},
+		inflection{rule: `(?i)^(ox)en`, replacement: "${1}"},
+		inflection{rule: `(?i)(vert|ind)ices$`, replacement: "${1}ex"},
+		inflection{rule: `(?i)(matr)ices$`, replacement: "${1}ix"},
+		inflection{rule: `(?i)(quiz)zes$`, replacement: "${1}"},
+		inflection{rule: `(?i)(database)s$`, replacement: "${1}"},
+	}
 }
 
-func (i *inflections) irregular() []inflection {
-	return []inflection{}
+// defaultIrregulars returns pairs of irregular words where rule is a singular form and replacement is a plural one
+func defaultIrregulars() []inflection {
+	return []inflection{
+		inflection{rule: "person", replacement: "people"},
+		inflection{rule: "man", replacement: "men"},
+		inflection{rule: "child", replacement: "children"},
+		inflection{rule: "sex", replacement: "sexes"},
+		inflection{rule: "move", replacement: "moves"},
+		inflection{rule: "zombie", replacement: "zombies"},
+	}
 }
 
-func (i *inflections) uncountable() []string {
+func defaultUncountables() []string {
 	return []string{"equipment", "information", "rice", "money", "species", "series", "fish", "sheep", "jeans", "police"}
 }
 
-// inflect.singular(/s$/i, "")
-// inflect.singular(/(ss)$/i, '\1')
-// inflect.singular(/(n)ews$/i, '\1ews')
-// inflect.singular(/([ti])a$/i, '\1um')
-// inflect.singular(/((a)naly|(b)a|(d)iagno|(p)arenthe|(p)rogno|(s)ynop|(t)he)(sis|ses)$/i, '\1sis')
-// inflect.singular(/(^analy)(sis|ses)$/i, '\1sis')
-// inflect.singular(/([^f])ves$/i, '\1fe')
-// inflect.singular(/(hive)s$/i, '\1')
-// inflect.singular(/(tive)s$/i, '\1')
-// inflect.singular(/([lr])ves$/i, '\1f')
-// inflect.singular(/([^aeiouy]|qu)ies$/i, '\1y')
-// inflect.singular(/(s)eries$/i, '\1eries')
-// inflect.singular(/(m)ovies$/i, '\1ovie')
-// inflect.singular(/(x|ch|ss|sh)es$/i, '\1')
-// inflect.singular(/^(m|l)ice$/i, '\1ouse')
-// inflect.singular(/(bus)(es)?$/i, '\1')
-// inflect.singular(/(o)es$/i, '\1')
-// inflect.singular(/(shoe)s$/i, '\1')
-// inflect.singular(/(cris|test)(is|es)$/i, '\1is')
-// inflect.singular(/^(a)x[ie]s$/i, '\1xis')
-// inflect.singular(/(octop|vir)(us|i)$/i, '\1us')
-// inflect.singular(/(alias|status)(es)?$/i, '\1')
-// inflect.singular(/^(ox)en/i, '\1')
-// inflect.singular(/(vert|ind)ices$/i, '\1ex')
-// inflect.singular(/(matr)ices$/i, '\1ix')
-// inflect.singular(/(quiz)zes$/i, '\1')
-// inflect.singular(/(database)s$/i, '\1')
-
-// inflect.irregular("person", "people")
-// inflect.irregular("man", "men")
-// inflect.irregular("child", "children")
-// inflect.irregular("sex", "sexes")
-// inflect.irregular("move", "moves")
-// inflect.irregular("zombie", "zombies")
-
-// inflect.uncountable(%w(equipment information rice money species series fish sheep jeans police))
+// defaultAcronyms returns common initialisms used in go identifiers
+func defaultAcronyms() []string {
+	return []string{
+		"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP", "HTTPS", "ID", "IP", "JSON", "LHS",
+		"QPS", "RAM", "RHS", "RPC", "SLA", "SMTP", "SQL", "SSH", "TCP", "TLS", "TTL", "UDP", "UI", "UID", "UUID", "URI",
+		"URL", "UTF8", "VM", "XML", "XMPP", "XSRF", "XSS",
+	}
+}
+
+// Plural adds a rule for pluralization, rule is a regular expression and replacement may refer to its groups (${1})
+func (i *Inflector) Plural(rule, replacement string) error {
+	re, err := regexp.Compile(rule)
+	if err != nil {
+		return err
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.plurals = append(i.plurals, compiledInflection{rule: re, replacement: replacement})
+	return nil
+}
+
+// Singular adds a rule for singularization, rule is a regular expression and replacement may refer to its groups (${1})
+func (i *Inflector) Singular(rule, replacement string) error {
+	re, err := regexp.Compile(rule)
+	if err != nil {
+		return err
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.singulars = append(i.singulars, compiledInflection{rule: re, replacement: replacement})
+	return nil
+}
+
+// Irregular adds rules for both directions of irregular word, first letter of the word is kept as it was in the given string
+//
+// Irregular("person", "people")
+func (i *Inflector) Irregular(singular, plural string) {
+	if len(singular) == 0 || len(plural) == 0 {
+		return
+	}
+
+	i.mu.Lock()
+	i.uncountables = deleteWords(i.uncountables, strings2.ToLower(singular), strings2.ToLower(plural))
+	i.mu.Unlock()
+
+	s := []rune(singular)
+	p := []rune(plural)
+	s0, sRest := string(s[0]), regexp.QuoteMeta(string(s[1:]))
+	p0, pRest := string(p[0]), regexp.QuoteMeta(string(p[1:]))
+
+	if strings2.EqualFold(s0, p0) {
+		i.mustPlural(`(?i)(`+regexp.QuoteMeta(s0)+`)`+sRest+`$`, "${1}"+string(p[1:]))
+		i.mustPlural(`(?i)(`+regexp.QuoteMeta(p0)+`)`+pRest+`$`, "${1}"+string(p[1:]))
+		i.mustSingular(`(?i)(`+regexp.QuoteMeta(s0)+`)`+sRest+`$`, "${1}"+string(s[1:]))
+		i.mustSingular(`(?i)(`+regexp.QuoteMeta(p0)+`)`+pRest+`$`, "${1}"+string(s[1:]))
+		return
+	}
+
+	upperS0, lowerS0 := strings2.ToUpper(s0), strings2.ToLower(s0)
+	upperP0, lowerP0 := strings2.ToUpper(p0), strings2.ToLower(p0)
+
+	i.mustPlural(regexp.QuoteMeta(upperS0)+`(?i:`+sRest+`)$`, upperP0+string(p[1:]))
+	i.mustPlural(regexp.QuoteMeta(lowerS0)+`(?i:`+sRest+`)$`, lowerP0+string(p[1:]))
+	i.mustPlural(regexp.QuoteMeta(upperP0)+`(?i:`+pRest+`)$`, upperP0+string(p[1:]))
+	i.mustPlural(regexp.QuoteMeta(lowerP0)+`(?i:`+pRest+`)$`, lowerP0+string(p[1:]))
+	i.mustSingular(regexp.QuoteMeta(upperS0)+`(?i:`+sRest+`)$`, upperS0+string(s[1:]))
+	i.mustSingular(regexp.QuoteMeta(lowerS0)+`(?i:`+sRest+`)$`, lowerS0+string(s[1:]))
+	i.mustSingular(regexp.QuoteMeta(upperP0)+`(?i:`+pRest+`)$`, upperS0+string(s[1:]))
+	i.mustSingular(regexp.QuoteMeta(lowerP0)+`(?i:`+pRest+`)$`, lowerS0+string(s[1:]))
+}
+
+// Uncountable adds words which should not be inflected
+func (i *Inflector) Uncountable(words ...string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, w := range words {
+		i.uncountables = append(i.uncountables, strings2.ToLower(w))
+	}
+}
+
+// Acronym registers the word which should be kept in the given form by Camelize and Humanize and treated as a single word by SnakeCase.
+// English inflector has go common initialisms (ID, URL, HTTP, JSON, API, etc.) registered by default, so unlike earlier versions
+// Humanize("id") returns "ID" instead of "Id" and Camelize("api_key") returns "APIKey" instead of "ApiKey".
+// Clear removes default acronyms together with other rules
+//
+// Acronym("GRPC")
+func (i *Inflector) Acronym(word string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.acronyms[strings2.ToLower(word)] = word
+}
+
+// Pluralize returns the plural form of the word in the string using rules of the inflector
+func (i *Inflector) Pluralize(str string) string {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.apply(str, i.plurals)
+}
+
+// Singularize returns the singular form of the word in the string using rules of the inflector
+func (i *Inflector) Singularize(str string) string {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.apply(str, i.singulars)
+}
+
+// Clear removes all the rules from the inflector
+func (i *Inflector) Clear() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.plurals = nil
+	i.singulars = nil
+	i.uncountables = nil
+	i.acronyms = make(map[string]string)
+}
+
+// acronym returns registered acronym for the given word
+func (i *Inflector) acronym(word string) (string, bool) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	a, ok := i.acronyms[strings2.ToLower(word)]
+	return a, ok
+}
+
+// acronymList returns registered acronyms, longer ones go first
+func (i *Inflector) acronymList() [][]rune {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	list := make([][]rune, 0, len(i.acronyms))
+	for _, a := range i.acronyms {
+		list = append(list, []rune(a))
+	}
+
+	sort.Slice(list, func(a, b int) bool {
+		if len(list[a]) != len(list[b]) {
+			return len(list[a]) > len(list[b])
+		}
+		return string(list[a]) < string(list[b])
+	})
+
+	return list
+}
+
+// mustPlural is used for rules which are known to be valid
+func (i *Inflector) mustPlural(rule, replacement string) {
+	if err := i.Plural(rule, replacement); err != nil {
+		panic(err)
+	}
+}
+
+// mustSingular is used for rules which are known to be valid
+func (i *Inflector) mustSingular(rule, replacement string) {
+	if err := i.Singular(rule, replacement); err != nil {
+		panic(err)
+	}
+}
+
+// isUncountable checks if the last word of the given string is uncountable
+func (i *Inflector) isUncountable(str string) bool {
+	lowerStr := strings2.ToLower(str)
+
+	for _, e := range i.uncountables {
+		if !strings2.HasSuffix(lowerStr, e) {
+			continue
+		}
+
+		rest := []rune(lowerStr[:len(lowerStr)-len(e)])
+		if len(rest) == 0 || !isWordRune(rest[len(rest)-1]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// apply applies the first matched rule starting from the last defined one
+func (i *Inflector) apply(str string, rules []compiledInflection) string {
+	if len(str) == 0 || i.isUncountable(str) {
+		return str
+	}
+
+	for idx := len(rules) - 1; idx >= 0; idx-- {
+		e := rules[idx]
+		loc := e.rule.FindStringSubmatchIndex(str)
+		if loc == nil {
+			continue
+		}
+
+		result := make([]byte, 0, len(str))
+		result = append(result, str[:loc[0]]...)
+		result = e.rule.ExpandString(result, e.replacement, str, loc)
+		result = append(result, str[loc[1]:]...)
+
+		return matchCase(str, string(result))
+	}
+
+	return str
+}
+
+func deleteWords(words []string, toDelete ...string) []string {
+	result := words[:0]
+
+	for _, w := range words {
+		keep := true
+		for _, d := range toDelete {
+			if w == d {
+				keep = false
+				break
+			}
+		}
+
+		if keep {
+			result = append(result, w)
+		}
+	}
+
+	return result
+}
+
+// matchCase upcases the result in case the original word was written in capital letters only
+func matchCase(original, result string) string {
+	hasLetters := false
+
+	for _, r := range original {
+		if unicode.IsLower(r) {
+			return result
+		}
+
+		if unicode.IsLetter(r) {
+			hasLetters = true
+		}
+	}
+
+	if hasLetters {
+		return strings2.ToUpper(result)
+	}
+
+	return result
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,156 @@
+package strings
+
+import "testing"
+
+func TestInflectorPluralize(t *testing.T) {
+	type testData struct {
+		initialStr string
+		response   string
+	}
+
+	inflector := NewInflector()
+	inflector.Plural(`$`, "s")
+	inflector.Plural(`(?i)(quiz)$`, "${1}zes")
+	inflector.Irregular("schema", "schemata")
+	inflector.Irregular("datum", "data")
+	inflector.Uncountable("metadata")
+
+	examples := map[string]testData{
+		"empty str":         testData{initialStr: "", response: ""},
+		"regular word":      testData{initialStr: "table", response: "tables"},
+		"last rule wins":    testData{initialStr: "quiz", response: "quizzes"},
+		"irregular word":    testData{initialStr: "schema", response: "schemata"},
+		"irregular plural":  testData{initialStr: "data", response: "data"},
+		"irregular in word": testData{initialStr: "user_datum", response: "user_data"},
+		"uncountable":       testData{initialStr: "metadata", response: "metadata"},
+	}
+
+	for k, v := range examples {
+		resp := inflector.Pluralize(v.initialStr)
+
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Inflector.Pluralize, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
+
+func TestInflectorSingularize(t *testing.T) {
+	type testData struct {
+		initialStr string
+		response   string
+	}
+
+	inflector := NewInflector()
+	inflector.Singular(`(?i)s$`, "")
+	inflector.Irregular("datum", "data")
+	inflector.Irregular("Ox", "oxen")
+
+	examples := map[string]testData{
+		"empty str":       testData{initialStr: "", response: ""},
+		"regular word":    testData{initialStr: "tables", response: "table"},
+		"irregular word":  testData{initialStr: "data", response: "datum"},
+		"capitalized":     testData{initialStr: "Data", response: "Datum"},
+		"different first": testData{initialStr: "oxen", response: "ox"},
+	}
+
+	for k, v := range examples {
+		resp := inflector.Singularize(v.initialStr)
+
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Inflector.Singularize, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
+
+func TestInflectorBadRule(t *testing.T) {
+	inflector := NewInflector()
+
+	if err := inflector.Plural(`(`, "s"); err == nil {
+		t.Errorf("test [bad rule] failed on method Inflector.Plural, expected error got %v", err)
+	}
+
+	if err := inflector.Singular(`(`, ""); err == nil {
+		t.Errorf("test [bad rule] failed on method Inflector.Singular, expected error got %v", err)
+	}
+}
+
+func TestInflections(t *testing.T) {
+	if Inflections("en") != Inflections("en") {
+		t.Errorf("test [same locale] failed on method Inflections, expected the same inflector")
+	}
+
+	es := Inflections("es")
+	es.Plural(`(?i)([aeiou])$`, "${1}s")
+	es.Plural(`(?i)([^aeiou])$`, "${1}es")
+	es.Singular(`(?i)s$`, "")
+	es.Singular(`(?i)([^aeiou])es$`, "${1}")
+
+	if resp := Pluralize("papel"); resp != "papels" {
+		t.Errorf("test [default locale] failed on method Pluralize, expected %v got %v", "papels", resp)
+	}
+
+	SetLocale("es")
+	defer SetLocale("en")
+
+	type testData struct {
+		initialStr string
+		response   string
+	}
+
+	examples := map[string]testData{
+		"vowel":     testData{initialStr: "libro", response: "libros"},
+		"consonant": testData{initialStr: "papel", response: "papeles"},
+	}
+
+	for k, v := range examples {
+		resp := Pluralize(v.initialStr)
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Pluralize, expected %v got %v", k, v.response, resp)
+		}
+
+		back := Singularize(resp)
+		if back != v.initialStr {
+			t.Errorf("test [%v] failed on method Singularize, expected %v got %v", k, v.initialStr, back)
+		}
+	}
+}
+
+func TestAcronym(t *testing.T) {
+	type testData struct {
+		initialStr string
+		camelized  string
+		humanized  string
+	}
+
+	inflector := Inflections("acronyms")
+	inflector.Acronym("HTML")
+	inflector.Acronym("SSL")
+	inflector.Acronym("OAuth")
+
+	SetLocale("acronyms")
+	defer SetLocale("en")
+
+	examples := map[string]testData{
+		"first word":  testData{initialStr: "html_parser", camelized: "HTMLParser", humanized: "HTML parser"},
+		"inner word":  testData{initialStr: "use_ssl", camelized: "UseSSL", humanized: "Use SSL"},
+		"no acronyms": testData{initialStr: "employee_salary", camelized: "EmployeeSalary", humanized: "Employee salary"},
+		"mixed case":  testData{initialStr: "oauth_token", camelized: "OAuthToken", humanized: "OAuth token"},
+	}
+
+	for k, v := range examples {
+		resp := Camelize(v.initialStr)
+		if resp != v.camelized {
+			t.Errorf("test [%v] failed on method Camelize, expected %v got %v", k, v.camelized, resp)
+		}
+
+		resp = Humanize(v.initialStr)
+		if resp != v.humanized {
+			t.Errorf("test [%v] failed on method Humanize, expected %v got %v", k, v.humanized, resp)
+		}
+
+		resp = SnakeCase(v.camelized)
+		if resp != v.initialStr {
+			t.Errorf("test [%v] failed on method SnakeCase, expected %v got %v", k, v.initialStr, resp)
+		}
+	}
+}
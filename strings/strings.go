@@ -2,15 +2,16 @@ package strings
 
 import (
 	"fmt"
+	"strconv"
 	strings2 "strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/maki5/rutils"
-
-	arrayOfStrings "github.com/maki5/rutils/arrays/of_string"
+	"github.com/maki5/rutils/arrays"
 )
 
-//At returns the substring of provided position
+//At returns the substring of provided position, positions are character (rune) positions, not byte offsets
 //
 //
 // str := "test_string"
@@ -19,8 +20,14 @@ import (
 //
 // At([]int{0, 1})   # => "te"
 func At(str string, pos interface{}) (string, error) {
+	runes := []rune(str)
+
 	if p, ok := pos.(int); ok {
-		return string(str[p]), nil
+		if p < 0 || p >= len(runes) {
+			return "", fmt.Errorf("position %v is out of range", p)
+		}
+
+		return string(runes[p]), nil
 	}
 
 	if p, ok := pos.([]int); ok {
@@ -28,45 +35,153 @@ func At(str string, pos interface{}) (string, error) {
 			return "", fmt.Errorf("wrong params, expected 2 got %v", len(p))
 		}
 
-		return string(str[p[0] : p[1]+1]), nil
+		if p[0] < 0 || p[1] >= len(runes) || p[0] > p[1]+1 {
+			return "", fmt.Errorf("range %v is out of range", p)
+		}
+
+		return string(runes[p[0] : p[1]+1]), nil
 	}
 	return "", fmt.Errorf("wrong params")
 }
 
-// Blank checks if string is empty, in case string contains only whitespaces it will be considered empty
+// Blank checks if string is empty, in case string contains only whitespaces (spaces, tabs, new lines and other unicode spaces) it will be considered empty
 func Blank(str string) bool {
-	if len(str) == 0 {
-		return true
+	return rutils.Blank(str)
+}
+
+// Present is the opposite of Blank
+func Present(str string) bool {
+	return !Blank(str)
+}
+
+// Squish removes whitespaces on both ends of the string, and then changes remaining consecutive whitespace groups into one space each
+//
+// Squish("  foo   bar    \n   \t   boo") # => "foo bar boo"
+func Squish(str string) string {
+	return strings2.Join(strings2.Fields(str), " ")
+}
+
+// Dedent removes indentation of the least indented non-blank line from every line of the string, so multi-line templates may be indented along with the code
+//
+// Dedent("    hello\n      world\n") # => "hello\n  world\n"
+func Dedent(str string) string {
+	lines := strings2.SplitAfter(str, "\n")
+
+	minIndent := -1
+	for _, l := range lines {
+		if Blank(l) {
+			continue
+		}
+
+		indent := len(l) - len(strings2.TrimLeft(l, " \t"))
+		if minIndent < 0 || indent < minIndent {
+			minIndent = indent
+		}
 	}
 
-	for _, s := range str {
-		if string(s) != " " {
-			return false
+	if minIndent <= 0 {
+		return str
+	}
+
+	var result strings2.Builder
+	result.Grow(len(str))
+
+	for _, l := range lines {
+		indent := len(l) - len(strings2.TrimLeft(l, " \t"))
+		if indent > minIndent {
+			indent = minIndent
 		}
+
+		result.WriteString(l[indent:])
 	}
 
-	return true
+	return result.String()
+}
+
+// StripHeredoc is an alias for Dedent, named after its rails counterpart
+func StripHeredoc(str string) string {
+	return Dedent(str)
 }
 
-// Camelize converts snake_case string into CamelCase
+// Indent indents the lines of the string with the given indent string repeated amount times, blank lines are not indented.
+// If indent string is empty, the first indentation character found in the string is used (tab or space), space otherwise
+//
+// Indent("foo\n\nbar", 2, " ") # => "  foo\n\n  bar"
+func Indent(str string, amount int, indentStr string) string {
+	if amount <= 0 {
+		return str
+	}
+
+	if len(indentStr) == 0 {
+		indentStr = " "
+		for _, l := range strings2.Split(str, "\n") {
+			if strings2.HasPrefix(l, "\t") {
+				indentStr = "\t"
+				break
+			}
+
+			if strings2.HasPrefix(l, " ") {
+				break
+			}
+		}
+	}
+
+	indent := strings2.Repeat(indentStr, amount)
+	lines := strings2.SplitAfter(str, "\n")
+
+	var result strings2.Builder
+	for _, l := range lines {
+		if !Blank(l) {
+			result.WriteString(indent)
+		}
+
+		result.WriteString(l)
+	}
+
+	return result.String()
+}
+
+// Camelize converts snake_case string into CamelCase, words registered as acronyms in the active inflector (and their plurals)
+// are kept in their registered form. Slashes are converted to "::" which makes it reverse to Underscore
+//
+// Camelize("active_model/errors") # => "ActiveModel::Errors"
+//
+// Camelize("user_ids")            # => "UserIDs"
 func Camelize(str string) string {
+	if strings2.Contains(str, "/") {
+		parts := strings2.Split(str, "/")
+		for i, p := range parts {
+			parts[i] = Camelize(p)
+		}
+
+		return strings2.Join(parts, "::")
+	}
+
 	stringsArr := strings2.Split(str, "_")
 
 	if len(stringsArr) == 0 {
 		return str
 	}
 
+	inflector := activeInflector()
+
 	var result string
 	for _, e := range stringsArr {
 		if len(e) == 0 {
 			continue
 		}
 
-		firstLetter := e[0]
-		remainingChars := e[1:]
+		if acronym, ok := inflector.acronym(e); ok {
+			result += acronym
+			continue
+		}
 
-		result += strings2.ToUpper(string(firstLetter))
-		result += remainingChars
+		if acronym, ok := inflector.acronym(strings2.TrimSuffix(e, "s")); ok && strings2.HasSuffix(e, "s") {
+			result += acronym + "s"
+			continue
+		}
+
+		result += Capitalize(e)
 	}
 
 	return result
@@ -77,11 +192,11 @@ func Capitalize(str string) string {
 	if len(str) == 0 {
 		return ""
 	}
-	firstLetter := str[0]
-	remainingChars := str[1:]
+	firstLetter, size := utf8.DecodeRuneInString(str)
+	remainingChars := str[size:]
 
 	var result string
-	result += strings2.ToUpper(string(firstLetter))
+	result += string(unicode.ToTitle(firstLetter))
 	result += remainingChars
 
 	return result
@@ -93,48 +208,54 @@ func Dasherize(str string) string {
 }
 
 // First returns the first character. If a limit is supplied, returns a substring from the beginning of the string until it reaches the limit value. If the given limit is greater than or equal to the string length, returns a copy of self.
+// Limit is counted in characters (runes)
 func First(str string, selectorArgs ...int) string {
 	if len(str) == 0 {
 		return ""
 	}
 
+	runes := []rune(str)
+
 	selector := 0
 	if len(selectorArgs) > 0 {
 		selector = selectorArgs[0]
 	} else {
-		return string(str[0])
+		return string(runes[0])
 	}
 
-	if selector == 0 {
+	if selector <= 0 {
 		return ""
 	}
 
-	if selector > len(str) {
+	if selector > len(runes) {
 		return str
 	}
-	return str[:selector]
+	return string(runes[:selector])
 }
 
-// From returns a substring from the given position to the end of the string. If the position is negative, it is counted from the end of the string.
+// From returns a substring from the given character position to the end of the string. If the position is negative, it is counted from the end of the string.
 func From(str string, selector int) string {
-	if len(str) == 0 || selector > len(str) {
+	runes := []rune(str)
+
+	if len(runes) == 0 || selector > len(runes) {
 		return ""
 	}
 
 	if selector < 0 {
 		positiveSelector := rutils.InverseInt(selector)
-		if positiveSelector < len(str) {
-			validSelector := len(str) - positiveSelector
-			return str[validSelector:]
+		if positiveSelector < len(runes) {
+			validSelector := len(runes) - positiveSelector
+			return string(runes[validSelector:])
 		}
 
 		return str
 	}
 
-	return str[selector:]
+	return string(runes[selector:])
 }
 
-// Humanize capitalizes the first word, turns underscores into spaces, and strips a trailing '_id' if present
+// Humanize capitalizes the first word, turns underscores into spaces, and strips a trailing '_id' if present.
+// Words registered as acronyms in the active inflector are kept in their registered form
 func Humanize(str string) string {
 	if len(str) == 0 {
 		return ""
@@ -146,11 +267,15 @@ func Humanize(str string) string {
 	}
 
 	str = strings2.Replace(str, "_id", "", -1)
-	stringsArr := arrayOfStrings.StringArray(strings2.Split(str, "_"))
+	stringsArr := arrays.ComparableArray[string](strings2.Split(str, "_"))
 	stringsArr.Delete("")
 
+	inflector := activeInflector()
+
 	for i, e := range stringsArr {
-		if i == 0 {
+		if acronym, ok := inflector.acronym(e); ok {
+			result += acronym
+		} else if i == 0 {
 			result += Capitalize(e)
 		} else {
 			result += e
@@ -165,81 +290,459 @@ func Humanize(str string) string {
 }
 
 // Last returns the last character of the string. If a limit is supplied, returns a substring from the end of the string until it reaches the limit value (counting backwards). If the given limit is greater than or equal to the string length, returns a copy of self.
+// Limit is counted in characters (runes)
 func Last(str string, selectorArgs ...int) string {
 	if len(str) == 0 {
 		return ""
 	}
 
+	runes := []rune(str)
+
 	selector := 0
 	if len(selectorArgs) > 0 {
 		selector = selectorArgs[0]
 	} else {
-		return string(str[len(str)-1])
+		return string(runes[len(runes)-1])
 	}
 
-	if selector == 0 {
+	if selector <= 0 {
 		return ""
 	}
 
-	if selector > len(str) {
+	if selector > len(runes) {
+		return str
+	}
+
+	selector = len(runes) - selector
+	return string(runes[selector:])
+
+}
+
+// TruncateOptions options for Truncate, TruncateWords and TruncateBytes
+type TruncateOptions struct {
+	// Omission is appended to the truncated string, "..." by default
+	Omission *string
+	// Separator is a natural break point, string is cut at its last occurrence before the limit.
+	// TruncateWords uses it to split words, whitespace is used by default
+	Separator string
+}
+
+const defaultOmission = "..."
+
+// Truncate truncates a given string after a given length if string is longer than length, length is counted in characters (runes) and includes omission
+//
+// Truncate("Once upon a time in a world far far away", 27)                                  # => "Once upon a time in a wo..."
+//
+// Truncate("Once upon a time in a world far far away", 27, TruncateOptions{Separator: " "}) # => "Once upon a time in a..."
+func Truncate(str string, length int, opts ...TruncateOptions) string {
+	runes := []rune(str)
+	if len(runes) <= length {
+		return str
+	}
+
+	options := truncateOptions(opts)
+	omission := defaultOmission
+	if options.Omission != nil {
+		omission = *options.Omission
+	}
+
+	stop := length - utf8.RuneCountInString(omission)
+	if stop < 0 {
+		stop = 0
+	}
+
+	truncated := string(runes[:stop])
+	if len(options.Separator) > 0 {
+		// separator may start at the stop position and end after it
+		end := stop + utf8.RuneCountInString(options.Separator)
+		if end > len(runes) {
+			end = len(runes)
+		}
+
+		end = len(string(runes[:end]))
+		if i := strings2.LastIndex(str[:end], options.Separator); i >= 0 {
+			truncated = str[:i]
+		}
+	}
+
+	return truncated + omission
+}
+
+// TruncateWords truncates a given string after a given number of words
+//
+// TruncateWords("Once upon a time in a world far far away", 4)                                   # => "Once upon a time..."
+//
+// TruncateWords("Once<br>upon<br>a<br>time<br>in<br>a<br>world", 5, TruncateOptions{Separator: "<br>"}) # => "Once<br>upon<br>a<br>time<br>in..."
+func TruncateWords(str string, wordsCount int, opts ...TruncateOptions) string {
+	options := truncateOptions(opts)
+	omission := defaultOmission
+	if options.Omission != nil {
+		omission = *options.Omission
+	}
+
+	// positions of the words in the string, pairs of start and end byte indexes
+	var words [][2]int
+	if len(options.Separator) == 0 {
+		start := -1
+		for i, r := range str {
+			if unicode.IsSpace(r) {
+				if start >= 0 {
+					words = append(words, [2]int{start, i})
+					start = -1
+				}
+			} else if start < 0 {
+				start = i
+			}
+		}
+
+		if start >= 0 {
+			words = append(words, [2]int{start, len(str)})
+		}
+	} else {
+		start := 0
+		for {
+			i := strings2.Index(str[start:], options.Separator)
+			if i < 0 {
+				words = append(words, [2]int{start, len(str)})
+				break
+			}
+
+			words = append(words, [2]int{start, start + i})
+			start += i + len(options.Separator)
+		}
+	}
+
+	if len(words) <= wordsCount {
 		return str
 	}
 
-	selector = len(str) - selector
-	return str[selector:]
+	if wordsCount <= 0 {
+		return omission
+	}
 
+	return str[:words[wordsCount-1][1]] + omission
 }
 
-// func Pluralize(str string) string {
-// 	if len(str) == 0 {
-// 		return str
-// 	}
+// TruncateBytes truncates a given string to the given number of bytes, multibyte characters and grapheme clusters are never split.
+// Returned string including omission is never longer than the given number of bytes, error is returned if omission is longer than that
+//
+// TruncateBytes("🔪🔪🔪", 10) # => "🔪..."
+func TruncateBytes(str string, bytesCount int, opts ...TruncateOptions) (string, error) {
+	if len(str) <= bytesCount {
+		return str, nil
+	}
+
+	options := truncateOptions(opts)
+	omission := defaultOmission
+	if options.Omission != nil {
+		omission = *options.Omission
+	}
+
+	if len(omission) > bytesCount {
+		return "", fmt.Errorf("omission %q is %v bytes, larger than the truncation length of %v bytes", omission, len(omission), bytesCount)
+	}
+
+	limit := bytesCount - len(omission)
+	end := 0
+	for _, g := range graphemes(str) {
+		if end+len(g) > limit {
+			break
+		}
+		end += len(g)
+	}
+
+	truncated := str[:end]
+	if len(options.Separator) > 0 {
+		if i := strings2.LastIndex(truncated, options.Separator); i >= 0 {
+			truncated = truncated[:i]
+		}
+	}
 
-// 	i := inflections{}
-// 	uncountable := i.uncountable()
-// 	if OfStrings.Contains(&uncountable, str) {
-// 		return str
-// 	}
+	return truncated + omission, nil
+}
 
-// 	for _, e := range i.plural() {
-// 		var re = regexp.MustCompile(e.rule)
-// 		s := re.ReplaceAllString(str, e.replacement)
-// 		if s != str {
-// 			return s
-// 		}
-// 	}
+func truncateOptions(opts []TruncateOptions) TruncateOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
 
-// 	return str
-// }
+	return TruncateOptions{}
+}
 
-// SnakeCase converts CamelCase to snake_case
+// Pluralize returns the plural form of the word in the string
+//
+// Pluralize("post")         # => "posts"
+//
+// Pluralize("octopus")      # => "octopi"
+//
+// Pluralize("sheep")        # => "sheep"
+//
+// Pluralize("CamelOctopus") # => "CamelOctopi"
+func Pluralize(str string) string {
+	return activeInflector().Pluralize(str)
+}
+
+// Singularize is the reverse of Pluralize, returns the singular form of a word in a string
+//
+// Singularize("posts")       # => "post"
+//
+// Singularize("octopi")      # => "octopus"
+//
+// Singularize("people")      # => "person"
+func Singularize(str string) string {
+	return activeInflector().Singularize(str)
+}
+
+// SnakeCase converts CamelCase to snake_case, consecutive capital letters and registered acronyms are kept as a single word
+//
+// SnakeCase("HelloStr")     # => "hello_str"
+//
+// SnakeCase("HTTPServerID") # => "http_server_id"
+//
+// SnakeCase("UserIDs")      # => "user_ids"
 func SnakeCase(str string) string {
-	wordsArr := make([]string, 0)
-	word := make([]rune, 0)
-	var result string
+	runes := []rune(str)
+	acronyms := activeInflector().acronymList()
+	result := make([]rune, 0, len(runes))
+
+	addSeparator := func() {
+		if len(result) > 0 && isLetterOrDigit(result[len(result)-1]) {
+			result = append(result, '_')
+		}
+	}
 
-	for i, e := range str {
-		if i != 0 && unicode.IsUpper(e) {
-			wordsArr = append(wordsArr, string(word))
-			word = word[:0]
+	wordStart := true
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		if wordStart {
+			if acronym := matchAcronym(runes, i, acronyms); acronym != nil {
+				addSeparator()
+				result = append(result, []rune(strings2.ToLower(string(acronym)))...)
+				i += len(acronym)
+				continue
+			}
 		}
 
-		runes := []rune(strings2.ToLower(string(e)))
-		word = append(word, runes[0])
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
 
-		if i == len(str)-1 {
-			wordsArr = append(wordsArr, string(word))
-			word = word[:0]
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				addSeparator()
+			}
 		}
+
+		result = append(result, unicode.ToLower(r))
+		wordStart = !isLetterOrDigit(r) || unicode.IsLower(r) || unicode.IsDigit(r)
+		i++
 	}
 
-	for i, e := range wordsArr {
-		result += e
-		if i < len(wordsArr)-1 {
-			result += "_"
+	return string(result)
+}
+
+// matchAcronym returns acronym which starts at the given position and is not followed by lowercase letters except plural "s"
+func matchAcronym(runes []rune, pos int, acronyms [][]rune) []rune {
+	for _, a := range acronyms {
+		end := pos + len(a)
+		if end > len(runes) || string(runes[pos:end]) != string(a) {
+			continue
+		}
+
+		if end < len(runes) && unicode.IsLower(runes[end]) && !isPluralSuffix(runes, end) {
+			continue
 		}
+
+		return a
 	}
-	return result
+
+	return nil
+}
+
+// isPluralSuffix checks if acronym ending at the given position is followed by plural "s" which ends the word: IDs, URLs
+func isPluralSuffix(runes []rune, pos int) bool {
+	return runes[pos] == 's' && (pos+1 == len(runes) || !unicode.IsLower(runes[pos+1]))
+}
+
+func isLetterOrDigit(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Titleize capitalizes all the words and replaces some characters in the string to create a nicer looking title
+//
+// Titleize("man from the boondocks") # => "Man From The Boondocks"
+//
+// Titleize("x-men: the last stand")  # => "X Men: The Last Stand"
+//
+// Titleize("TheManWithoutAPast")     # => "The Man Without A Past"
+func Titleize(str string) string {
+	str = strings2.TrimSuffix(SnakeCase(strings2.Replace(str, "-", "_", -1)), "_id")
+	runes := []rune(strings2.Replace(str, "_", " ", -1))
+	inflector := activeInflector()
+
+	var result strings2.Builder
+	for i := 0; i < len(runes); {
+		if !isLetterOrDigit(runes[i]) {
+			result.WriteRune(runes[i])
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(runes) && isLetterOrDigit(runes[end]) {
+			end++
+		}
+
+		word := string(runes[i:end])
+		if acronym, ok := inflector.acronym(word); ok {
+			result.WriteString(acronym)
+		} else if i > 0 && isApostrophe(runes[i-1]) {
+			result.WriteString(word)
+		} else {
+			result.WriteString(Capitalize(word))
+		}
+
+		i = end
+	}
+
+	return result.String()
+}
+
+// Underscore makes an underscored, lowercase form from the expression in the string, "::" and "." namespace separators are converted to "/"
+//
+// Underscore("ActiveModel")         # => "active_model"
+//
+// Underscore("ActiveModel::Errors") # => "active_model/errors"
+//
+// Underscore("http.ResponseWriter") # => "http/response_writer"
+func Underscore(str string) string {
+	str = strings2.Replace(str, "::", "/", -1)
+	str = strings2.Replace(str, ".", "/", -1)
+	str = strings2.Replace(str, "-", "_", -1)
+
+	return SnakeCase(str)
+}
+
+// Tableize creates the name of a table like rails does for models to table names
+//
+// Tableize("RawScaledScorer") # => "raw_scaled_scorers"
+//
+// Tableize("fancyCategory")   # => "fancy_categories"
+func Tableize(str string) string {
+	return Pluralize(Underscore(str))
+}
+
+// Classify creates a struct name from a plural table name, schema prefix is removed
+//
+// Classify("ham_and_eggs") # => "HamAndEgg"
+//
+// Classify("schema.posts") # => "Post"
+func Classify(str string) string {
+	if i := strings2.LastIndex(str, "."); i >= 0 {
+		str = str[i+1:]
+	}
+
+	return Camelize(Singularize(str))
+}
+
+// ForeignKey creates a foreign key name from a struct name. Underscore between the name and "id" can be omitted by passing false
+//
+// ForeignKey("Message")        # => "message_id"
+//
+// ForeignKey("Message", false) # => "messageid"
+//
+// ForeignKey("Admin::Post")    # => "post_id"
+func ForeignKey(str string, separateWithUnderscore ...bool) string {
+	separator := "_"
+	if len(separateWithUnderscore) > 0 && !separateWithUnderscore[0] {
+		separator = ""
+	}
+
+	return Underscore(Demodulize(str)) + separator + "id"
+}
+
+// Demodulize removes the module part from the expression in the string, both "::" and "." are treated as separators
+//
+// Demodulize("ActiveSupport::Inflector::Inflections") # => "Inflections"
+//
+// Demodulize("http.Client")                           # => "Client"
+func Demodulize(str string) string {
+	if i := lastNamespaceSeparator(str); i >= 0 {
+		if strings2.HasPrefix(str[i:], "::") {
+			return str[i+2:]
+		}
+
+		return str[i+1:]
+	}
+
+	return str
+}
+
+// Deconstantize removes the rightmost segment from the constant expression in the string
+//
+// Deconstantize("Net::HTTP")   # => "Net"
+//
+// Deconstantize("::Net::HTTP") # => "::Net"
+//
+// Deconstantize("String")      # => ""
+func Deconstantize(str string) string {
+	if i := lastNamespaceSeparator(str); i >= 0 {
+		return str[:i]
+	}
+
+	return ""
+}
+
+// Ordinal returns the suffix that should be added to a number to denote the position in an ordered sequence
+//
+// Ordinal(1)  # => "st"
+//
+// Ordinal(12) # => "th"
+func Ordinal(number int) string {
+	abs := number
+	if abs < 0 {
+		abs = rutils.InverseInt(abs)
+	}
+
+	if abs%100 >= 11 && abs%100 <= 13 {
+		return "th"
+	}
+
+	switch abs % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	default:
+		return "th"
+	}
+}
+
+// Ordinalize turns a number into an ordinal string used to denote the position in an ordered sequence
+//
+// Ordinalize(1)   # => "1st"
+//
+// Ordinalize(-11) # => "-11th"
+func Ordinalize(number int) string {
+	return strconv.Itoa(number) + Ordinal(number)
+}
+
+// lastNamespaceSeparator returns byte index of the last "::" or "." separator, -1 if there is no separator
+func lastNamespaceSeparator(str string) int {
+	colons := strings2.LastIndex(str, "::")
+	dot := strings2.LastIndex(str, ".")
+
+	if dot > colons {
+		return dot
+	}
+
+	return colons
+}
+
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’' || r == '`'
 }
 
 // HasOnlyLetters checks if string has only letters
@@ -256,36 +759,29 @@ func HasOnlyLetters(str string) bool {
 	return true
 }
 
-// Insert inserts given string after charachter at the given index
+// Insert inserts given string before the character at the given index, index is a character (rune) position
 func Insert(str string, index int, strToInsert string) string {
-	if Blank(str) {
-		if len(str) < index {
-			return str
-		}
+	runes := []rune(str)
 
-		return strToInsert
-	}
-
-	if len(str) < index {
+	if len(runes) < index || index < 0 {
 		return str
 	}
 
-	firstPart := str[:index]
-	secondPart := str[index:]
+	firstPart := string(runes[:index])
+	secondPart := string(runes[index:])
 
 	result := firstPart + strToInsert + secondPart
 	return result
 }
 
-// Reverse reverses given string
+// Reverse reverses given string, grapheme clusters (letters with combining marks, emoji sequences, flags) are kept intact
 func Reverse(str string) string {
-	if Blank(str) {
-		return str
-	}
+	clusters := graphemes(str)
 
-	var result string
-	for i := len(str); i > 0; i-- {
-		result += string(str[i-1])
+	var result strings2.Builder
+	result.Grow(len(str))
+	for i := len(clusters); i > 0; i-- {
+		result.WriteString(clusters[i-1])
 	}
-	return result
+	return result.String()
 }
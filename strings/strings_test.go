@@ -15,11 +15,15 @@ func TestAt(t *testing.T) {
 	examples := map[string]testData{
 		"first_char":      testData{initialStr: "test_string", selector: 0, response: "t"},
 		"first_two_chars": testData{initialStr: "test_string", selector: []int{0, 1}, response: "te"},
+		"multibyte char":  testData{initialStr: "héllo", selector: 1, response: "é"},
+		"multibyte range": testData{initialStr: "привет", selector: []int{1, 3}, response: "рив"},
 	}
 
 	badExamples := map[string]testData{
 		"incorrect_params_type":      testData{initialStr: "test_string", selector: "0"},
 		"incorrect_number_of_params": testData{initialStr: "test_string", selector: []int{0, 1, 2}},
+		"out_of_range":               testData{initialStr: "héllo", selector: 5},
+		"range_out_of_range":         testData{initialStr: "héllo", selector: []int{3, 5}},
 	}
 
 	for k, v := range examples {
@@ -51,6 +55,8 @@ func TestBlank(t *testing.T) {
 		"non empty string":                             testData{initialStr: "bfhdjsbfhdjs", response: false},
 		"non empty string with whitespaces":            testData{initialStr: "     bfhdjsbfhdjs", response: false},
 		"non empty string with whitespaces at the end": testData{initialStr: "bfhdjsbfhdjs     ", response: false},
+		"tabs and new lines":                           testData{initialStr: "\t\n \r\n", response: true},
+		"unicode spaces":                               testData{initialStr: "\u00a0\u2003", response: true},
 	}
 
 	for k, v := range examples {
@@ -58,6 +64,93 @@ func TestBlank(t *testing.T) {
 		if v.response != resp {
 			t.Errorf("test [%v] failed on method Blank, expected %v got %v", k, v.response, resp)
 		}
+
+		resp = utils.Blank(v.initialStr)
+		if v.response != resp {
+			t.Errorf("test [%v] failed on method rutils.Blank, expected %v got %v", k, v.response, resp)
+		}
+
+		resp = Present(v.initialStr)
+		if v.response == resp {
+			t.Errorf("test [%v] failed on method Present, expected %v got %v", k, !v.response, resp)
+		}
+	}
+}
+
+func TestSquish(t *testing.T) {
+	type testData struct {
+		initialStr string
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty string":       testData{initialStr: "", response: ""},
+		"only whitespaces":   testData{initialStr: " \t\n ", response: ""},
+		"regular string":     testData{initialStr: "foo bar", response: "foo bar"},
+		"mixed whitespaces":  testData{initialStr: "  foo   bar    \n   \t   boo", response: "foo bar boo"},
+		"unicode whitespace": testData{initialStr: "foo\u00a0\u00a0bar", response: "foo bar"},
+	}
+
+	for k, v := range examples {
+		resp := Squish(v.initialStr)
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Squish, expected %q got %q", k, v.response, resp)
+		}
+	}
+}
+
+func TestDedent(t *testing.T) {
+	type testData struct {
+		initialStr string
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty string":        testData{initialStr: "", response: ""},
+		"no indentation":      testData{initialStr: "foo\n  bar\n", response: "foo\n  bar\n"},
+		"common indentation":  testData{initialStr: "    hello\n      world\n", response: "hello\n  world\n"},
+		"blank lines ignored": testData{initialStr: "    hello\n\n  \n      world", response: "hello\n\n\n  world"},
+		"tabs":                testData{initialStr: "\t\tfoo\n\tbar", response: "\tfoo\nbar"},
+		"leading new line":    testData{initialStr: "\n  foo:\n    bar: 1\n", response: "\nfoo:\n  bar: 1\n"},
+	}
+
+	for k, v := range examples {
+		resp := Dedent(v.initialStr)
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Dedent, expected %q got %q", k, v.response, resp)
+		}
+
+		resp = StripHeredoc(v.initialStr)
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method StripHeredoc, expected %q got %q", k, v.response, resp)
+		}
+	}
+}
+
+func TestIndent(t *testing.T) {
+	type testData struct {
+		initialStr string
+		amount     int
+		indentStr  string
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty string":       testData{initialStr: "", amount: 2, indentStr: " ", response: ""},
+		"zero amount":        testData{initialStr: "foo", amount: 0, indentStr: " ", response: "foo"},
+		"single line":        testData{initialStr: "foo", amount: 2, indentStr: " ", response: "  foo"},
+		"blank lines":        testData{initialStr: "foo\n\nbar\n", amount: 2, indentStr: " ", response: "  foo\n\n  bar\n"},
+		"custom indent":      testData{initialStr: "foo\nbar", amount: 1, indentStr: "> ", response: "> foo\n> bar"},
+		"guess tab":          testData{initialStr: "foo\n\tbar", amount: 1, response: "\tfoo\n\t\tbar"},
+		"guess space":        testData{initialStr: "foo\n  bar", amount: 2, response: "  foo\n    bar"},
+		"nested config file": testData{initialStr: "a: 1\nb:\n  c: 2", amount: 2, indentStr: " ", response: "  a: 1\n  b:\n    c: 2"},
+	}
+
+	for k, v := range examples {
+		resp := Indent(v.initialStr, v.amount, v.indentStr)
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Indent, expected %q got %q", k, v.response, resp)
+		}
 	}
 }
 
@@ -73,6 +166,13 @@ func TestCamelize(t *testing.T) {
 		"only whitespaces string":    testData{initialStr: "   ", response: "   "},
 		"string with whitespace":     testData{initialStr: "test string", response: "Test string"},
 		"snake case string":          testData{initialStr: "test_string", response: "TestString"},
+		"multibyte first letter":     testData{initialStr: "élan_vital", response: "ÉlanVital"},
+		"acronyms":                   testData{initialStr: "http_server_id", response: "HTTPServerID"},
+		"acronym with digit":         testData{initialStr: "utf8_encoder", response: "UTF8Encoder"},
+		"plural acronym":             testData{initialStr: "user_ids", response: "UserIDs"},
+		"default acronym id":         testData{initialStr: "user_id", response: "UserID"},
+		"default acronym api":        testData{initialStr: "api_key", response: "APIKey"},
+		"words ending with s":        testData{initialStr: "users_status", response: "UsersStatus"},
 	}
 
 	for k, v := range examples {
@@ -83,6 +183,29 @@ func TestCamelize(t *testing.T) {
 	}
 }
 
+func TestCapitalize(t *testing.T) {
+	type testData struct {
+		initialStr string
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty string":       testData{initialStr: "", response: ""},
+		"regular string":     testData{initialStr: "hello world", response: "Hello world"},
+		"capitalized string": testData{initialStr: "Hello", response: "Hello"},
+		"multibyte letter":   testData{initialStr: "élan", response: "Élan"},
+		"cyrillic":           testData{initialStr: "привет", response: "Привет"},
+		"digraph":            testData{initialStr: "ǆungla", response: "ǅungla"},
+	}
+
+	for k, v := range examples {
+		resp := Capitalize(v.initialStr)
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Capitalize, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
+
 func TestDasherize(t *testing.T) {
 	type testData struct {
 		initialStr string
@@ -120,6 +243,8 @@ func TestFirst(t *testing.T) {
 		"zero":                                testData{initialStr: "hello", selector: utils.IntPtr(0), response: ""},
 		"all":                                 testData{initialStr: "hello", selector: utils.IntPtr(6), response: "hello"},
 		"selector bigger then initial string": testData{initialStr: "hello", selector: utils.IntPtr(7), response: "hello"},
+		"multibyte without selector":          testData{initialStr: "élan", response: "é"},
+		"multibyte with selector":             testData{initialStr: "привет", selector: utils.IntPtr(2), response: "пр"},
 	}
 
 	for k, v := range examples {
@@ -150,6 +275,8 @@ func TestFrom(t *testing.T) {
 		"negative selector":         testData{initialStr: "hello", selector: -2, response: "lo"},
 		"selector too big":          testData{initialStr: "hello", selector: 10, response: ""},
 		"negative selector too big": testData{initialStr: "hello", selector: -10, response: "hello"},
+		"multibyte":                 testData{initialStr: "привет", selector: 3, response: "вет"},
+		"multibyte negative":        testData{initialStr: "héllo", selector: -4, response: "éllo"},
 	}
 
 	for k, v := range examples {
@@ -175,6 +302,10 @@ func TestHumanize(t *testing.T) {
 		"just `_id`":                   testData{initialStr: "_id", response: "Id"},
 		"`_id` with snake case string": testData{initialStr: "_id_test", response: "Test"},
 		"non snake case string":        testData{initialStr: "some string", response: "Some string"},
+		"plural id suffix":             testData{initialStr: "user_ids", response: "Users"},
+		"default acronym id":           testData{initialStr: "id", response: "ID"},
+		"default acronym api":          testData{initialStr: "api_key", response: "API key"},
+		"default acronym not first":    testData{initialStr: "remote_ip_address", response: "Remote IP address"},
 	}
 
 	for k, v := range examples {
@@ -202,6 +333,8 @@ func TestLast(t *testing.T) {
 		"zero":                                testData{initialStr: "hello", selector: utils.IntPtr(0), response: ""},
 		"all":                                 testData{initialStr: "hello", selector: utils.IntPtr(6), response: "hello"},
 		"selector bigger then initial string": testData{initialStr: "hello", selector: utils.IntPtr(7), response: "hello"},
+		"multibyte without selector":          testData{initialStr: "café", response: "é"},
+		"multibyte with selector":             testData{initialStr: "привет", selector: utils.IntPtr(2), response: "ет"},
 	}
 
 	for k, v := range examples {
@@ -218,30 +351,77 @@ func TestLast(t *testing.T) {
 	}
 }
 
-// func TestPluralize(t *testing.T) {
-// 	type testData struct {
-// 		initialStr string
-// 		response   string
-// 	}
-
-// 	examples := map[string]testData{
-// 		"empty str":    testData{initialStr: "", response: ""},
-// 		"post":         testData{initialStr: "post", response: "posts"},
-// 		"octopus":      testData{initialStr: "octopus", response: "octopi"},
-// 		"sheep":        testData{initialStr: "sheep", response: "sheep"},
-// 		"words":        testData{initialStr: "words", response: "words"},
-// 		"CamelOctopus": testData{initialStr: "CamelOctopus", response: "CamelOctopi"},
-// 	}
-
-// 	for k, v := range examples {
-// 		var resp string
-// 		resp = Pluralize(v.initialStr)
-
-// 		if resp != v.response {
-// 			t.Errorf("test [%v] failed on method Pluralize, expected %v got %v", k, v.response, resp)
-// 		}
-// 	}
-// }
+func TestPluralize(t *testing.T) {
+	type testData struct {
+		initialStr string
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty str":    testData{initialStr: "", response: ""},
+		"post":         testData{initialStr: "post", response: "posts"},
+		"octopus":      testData{initialStr: "octopus", response: "octopi"},
+		"sheep":        testData{initialStr: "sheep", response: "sheep"},
+		"words":        testData{initialStr: "words", response: "words"},
+		"CamelOctopus": testData{initialStr: "CamelOctopus", response: "CamelOctopi"},
+		"person":       testData{initialStr: "person", response: "people"},
+		"child":        testData{initialStr: "child", response: "children"},
+		"salesperson":  testData{initialStr: "salesperson", response: "salespeople"},
+		"capitalized":  testData{initialStr: "Person", response: "People"},
+		"upper case":   testData{initialStr: "CHILD", response: "CHILDREN"},
+		"category":     testData{initialStr: "category", response: "categories"},
+		"status":       testData{initialStr: "status", response: "statuses"},
+		"mouse":        testData{initialStr: "mouse", response: "mice"},
+		"ox":           testData{initialStr: "ox", response: "oxen"},
+		"uncountable":  testData{initialStr: "Information", response: "Information"},
+		"compound":     testData{initialStr: "rice_bag", response: "rice_bags"},
+		"last word":    testData{initialStr: "brown rice", response: "brown rice"},
+	}
+
+	for k, v := range examples {
+		var resp string
+		resp = Pluralize(v.initialStr)
+
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Pluralize, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
+
+func TestSingularize(t *testing.T) {
+	type testData struct {
+		initialStr string
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty str":   testData{initialStr: "", response: ""},
+		"posts":       testData{initialStr: "posts", response: "post"},
+		"octopi":      testData{initialStr: "octopi", response: "octopus"},
+		"sheep":       testData{initialStr: "sheep", response: "sheep"},
+		"word":        testData{initialStr: "word", response: "word"},
+		"people":      testData{initialStr: "people", response: "person"},
+		"children":    testData{initialStr: "Children", response: "Child"},
+		"upper case":  testData{initialStr: "PEOPLE", response: "PERSON"},
+		"categories":  testData{initialStr: "categories", response: "category"},
+		"statuses":    testData{initialStr: "statuses", response: "status"},
+		"analyses":    testData{initialStr: "analyses", response: "analysis"},
+		"mice":        testData{initialStr: "mice", response: "mouse"},
+		"oxen":        testData{initialStr: "oxen", response: "ox"},
+		"databases":   testData{initialStr: "databases", response: "database"},
+		"uncountable": testData{initialStr: "series", response: "series"},
+		"camel case":  testData{initialStr: "RawScaledScorers", response: "RawScaledScorer"},
+	}
+
+	for k, v := range examples {
+		var resp string
+		resp = Singularize(v.initialStr)
+
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Singularize, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
 
 func TestSnakeCase(t *testing.T) {
 	type testData struct {
@@ -256,6 +436,18 @@ func TestSnakeCase(t *testing.T) {
 		"simple str":             testData{initialStr: "hello", response: "hello"},
 		"complex camel case str": testData{initialStr: "HelloStrStr", response: "hello_str_str"},
 		"complex snake case str": testData{initialStr: "hello_str_str", response: "hello_str_str"},
+		"acronyms":               testData{initialStr: "HTTPServerID", response: "http_server_id"},
+		"acronym at the end":     testData{initialStr: "UserID", response: "user_id"},
+		"consecutive acronyms":   testData{initialStr: "JSONAPIResponse", response: "json_api_response"},
+		"acronym with digit":     testData{initialStr: "UTF8Encoder", response: "utf8_encoder"},
+		"lower camel case":       testData{initialStr: "simpleXMLParser", response: "simple_xml_parser"},
+		"capitals in a word":     testData{initialStr: "PAID", response: "paid"},
+		"plural acronym":         testData{initialStr: "UserIDs", response: "user_ids"},
+		"plural acronym in name": testData{initialStr: "UserIDsCount", response: "user_ids_count"},
+		"plural acronym only":    testData{initialStr: "URLs", response: "urls"},
+		"capitalized acronym":    testData{initialStr: "UserId", response: "user_id"},
+		"capitalized plural":     testData{initialStr: "Ids", response: "ids"},
+		"acronym prefix of word": testData{initialStr: "IDsum", response: "i_dsum"},
 	}
 
 	for k, v := range examples {
@@ -309,6 +501,9 @@ func TestInsert(t *testing.T) {
 		"word with whitespaces":    testData{initialStr: "hello", index: 2, strToInsert: " word ", response: "he word llo"},
 		"at start of str":          testData{initialStr: "hello", index: 0, strToInsert: "l", response: "lhello"},
 		"at end of str":            testData{initialStr: "hello", index: 5, strToInsert: "l", response: "hellol"},
+		"whitespace only":          testData{initialStr: "\t\t", index: 1, strToInsert: "x", response: "\tx\t"},
+		"bad index with blank str": testData{initialStr: "  ", index: 3, strToInsert: "x", response: "  "},
+		"multibyte":                testData{initialStr: "héllo", index: 2, strToInsert: "-", response: "hé-llo"},
 	}
 
 	for k, v := range examples {
@@ -327,8 +522,15 @@ func TestReverse(t *testing.T) {
 	}
 
 	examples := map[string]testData{
-		"empty string":   testData{initialStr: "", response: ""},
-		"regular string": testData{initialStr: "hello", response: "olleh"},
+		"empty string":    testData{initialStr: "", response: ""},
+		"regular string":  testData{initialStr: "hello", response: "olleh"},
+		"multibyte":       testData{initialStr: "héllo", response: "olléh"},
+		"combining mark":  testData{initialStr: "he\u0301llo", response: "olle\u0301h"},
+		"emoji zwj":       testData{initialStr: "a\U0001F468\u200d\U0001F469\u200d\U0001F467b", response: "b\U0001F468\u200d\U0001F469\u200d\U0001F467a"},
+		"skin tone":       testData{initialStr: "\U0001F44D\U0001F3FDok", response: "ko\U0001F44D\U0001F3FD"},
+		"flags":           testData{initialStr: "\U0001F1FA\U0001F1E6\U0001F1E9\U0001F1EA", response: "\U0001F1E9\U0001F1EA\U0001F1FA\U0001F1E6"},
+		"crlf":            testData{initialStr: "a\r\nb", response: "b\r\na"},
+		"whitespace only": testData{initialStr: " \t\n", response: "\n\t "},
 	}
 
 	for k, v := range examples {
@@ -339,3 +541,321 @@ func TestReverse(t *testing.T) {
 		}
 	}
 }
+
+func TestTitleize(t *testing.T) {
+	type testData struct {
+		initialStr string
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty string":    testData{initialStr: "", response: ""},
+		"regular string":  testData{initialStr: "man from the boondocks", response: "Man From The Boondocks"},
+		"dashes":          testData{initialStr: "x-men: the last stand", response: "X Men: The Last Stand"},
+		"camel case":      testData{initialStr: "TheManWithoutAPast", response: "The Man Without A Past"},
+		"snake case":      testData{initialStr: "raiders_of_the_lost_ark", response: "Raiders Of The Lost Ark"},
+		"apostrophe":      testData{initialStr: "string's title", response: "String's Title"},
+		"id suffix":       testData{initialStr: "author_id", response: "Author"},
+		"acronym":         testData{initialStr: "html_api_docs", response: "HTML API Docs"},
+		"multibyte words": testData{initialStr: "école élémentaire", response: "École Élémentaire"},
+		"periods":         testData{initialStr: "Mr. Smith goes to Washington", response: "Mr. Smith Goes To Washington"},
+		"version number":  testData{initialStr: "version 1.2 release", response: "Version 1.2 Release"},
+	}
+
+	for k, v := range examples {
+		resp := Titleize(v.initialStr)
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Titleize, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
+
+func TestUnderscore(t *testing.T) {
+	type testData struct {
+		initialStr string
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty string": testData{initialStr: "", response: ""},
+		"camel case":   testData{initialStr: "ActiveModel", response: "active_model"},
+		"namespace":    testData{initialStr: "ActiveModel::Errors", response: "active_model/errors"},
+		"go package":   testData{initialStr: "http.ResponseWriter", response: "http/response_writer"},
+		"dashes":       testData{initialStr: "Some-Words", response: "some_words"},
+		"acronym":      testData{initialStr: "SSLError::HTTPServer", response: "ssl_error/http_server"},
+	}
+
+	for k, v := range examples {
+		resp := Underscore(v.initialStr)
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Underscore, expected %v got %v", k, v.response, resp)
+		}
+	}
+
+	for _, v := range []string{"ActiveModel::Errors", "HTTPServer::RequestID"} {
+		resp := Camelize(Underscore(v))
+		if resp != v {
+			t.Errorf("test [round trip] failed on methods Underscore and Camelize, expected %v got %v", v, resp)
+		}
+	}
+}
+
+func TestTableize(t *testing.T) {
+	type testData struct {
+		initialStr string
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty string":  testData{initialStr: "", response: ""},
+		"camel case":    testData{initialStr: "RawScaledScorer", response: "raw_scaled_scorers"},
+		"snake case":    testData{initialStr: "ham_and_egg", response: "ham_and_eggs"},
+		"lower camel":   testData{initialStr: "fancyCategory", response: "fancy_categories"},
+		"irregular":     testData{initialStr: "Person", response: "people"},
+		"acronym first": testData{initialStr: "APIKey", response: "api_keys"},
+	}
+
+	for k, v := range examples {
+		resp := Tableize(v.initialStr)
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Tableize, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
+
+func TestClassify(t *testing.T) {
+	type testData struct {
+		initialStr string
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty string":  testData{initialStr: "", response: ""},
+		"table name":    testData{initialStr: "ham_and_eggs", response: "HamAndEgg"},
+		"schema prefix": testData{initialStr: "schema.posts", response: "Post"},
+		"singular":      testData{initialStr: "calculus", response: "Calculu"},
+		"irregular":     testData{initialStr: "people", response: "Person"},
+		"acronym":       testData{initialStr: "api_keys", response: "APIKey"},
+	}
+
+	for k, v := range examples {
+		resp := Classify(v.initialStr)
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Classify, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
+
+func TestForeignKey(t *testing.T) {
+	type testData struct {
+		initialStr string
+		separate   *bool
+		response   string
+	}
+
+	noUnderscore := false
+
+	examples := map[string]testData{
+		"struct name":   testData{initialStr: "Message", response: "message_id"},
+		"no underscore": testData{initialStr: "Message", separate: &noUnderscore, response: "messageid"},
+		"namespace":     testData{initialStr: "Admin::Post", response: "post_id"},
+		"camel case":    testData{initialStr: "UserAccount", response: "user_account_id"},
+	}
+
+	for k, v := range examples {
+		var resp string
+		if v.separate == nil {
+			resp = ForeignKey(v.initialStr)
+		} else {
+			resp = ForeignKey(v.initialStr, *v.separate)
+		}
+
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method ForeignKey, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
+
+func TestDemodulize(t *testing.T) {
+	type testData struct {
+		initialStr string
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty string":     testData{initialStr: "", response: ""},
+		"nested namespace": testData{initialStr: "ActiveSupport::Inflector::Inflections", response: "Inflections"},
+		"go package":       testData{initialStr: "http.Client", response: "Client"},
+		"no namespace":     testData{initialStr: "Inflections", response: "Inflections"},
+		"leading colons":   testData{initialStr: "::Inflections", response: "Inflections"},
+	}
+
+	for k, v := range examples {
+		resp := Demodulize(v.initialStr)
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Demodulize, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
+
+func TestDeconstantize(t *testing.T) {
+	type testData struct {
+		initialStr string
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty string":     testData{initialStr: "", response: ""},
+		"namespace":        testData{initialStr: "Net::HTTP", response: "Net"},
+		"leading colons":   testData{initialStr: "::Net::HTTP", response: "::Net"},
+		"no namespace":     testData{initialStr: "String", response: ""},
+		"nested namespace": testData{initialStr: "ActiveSupport::Inflector::Inflections", response: "ActiveSupport::Inflector"},
+		"go package":       testData{initialStr: "net/http.Client", response: "net/http"},
+	}
+
+	for k, v := range examples {
+		resp := Deconstantize(v.initialStr)
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Deconstantize, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
+
+func TestOrdinalize(t *testing.T) {
+	type testData struct {
+		number   int
+		response string
+	}
+
+	examples := map[string]testData{
+		"zero":     testData{number: 0, response: "0th"},
+		"first":    testData{number: 1, response: "1st"},
+		"second":   testData{number: 2, response: "2nd"},
+		"third":    testData{number: 3, response: "3rd"},
+		"fourth":   testData{number: 4, response: "4th"},
+		"eleventh": testData{number: 11, response: "11th"},
+		"twelfth":  testData{number: 112, response: "112th"},
+		"1021st":   testData{number: 1021, response: "1021st"},
+		"negative": testData{number: -21, response: "-21st"},
+		"-11th":    testData{number: -11, response: "-11th"},
+	}
+
+	for k, v := range examples {
+		resp := Ordinalize(v.number)
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Ordinalize, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	type testData struct {
+		initialStr string
+		length     int
+		opts       *TruncateOptions
+		response   string
+	}
+
+	longStr := "Once upon a time in a world far far away"
+
+	examples := map[string]testData{
+		"empty string":      testData{initialStr: "", length: 5, response: ""},
+		"short string":      testData{initialStr: "hello", length: 5, response: "hello"},
+		"long string":       testData{initialStr: longStr, length: 27, response: "Once upon a time in a wo..."},
+		"separator":         testData{initialStr: longStr, length: 27, opts: &TruncateOptions{Separator: " "}, response: "Once upon a time in a..."},
+		"missing separator": testData{initialStr: longStr, length: 27, opts: &TruncateOptions{Separator: "|"}, response: "Once upon a time in a wo..."},
+		"custom omission":   testData{initialStr: "And they found that many people were sleeping better.", length: 25, opts: &TruncateOptions{Omission: utils.StringPtr("... (continued)")}, response: "And they f... (continued)"},
+		"empty omission":    testData{initialStr: "hello world", length: 5, opts: &TruncateOptions{Omission: utils.StringPtr("")}, response: "hello"},
+		"too short length":  testData{initialStr: "hello world", length: 2, response: "..."},
+		"multibyte":         testData{initialStr: "привет мир", length: 6, response: "при..."},
+	}
+
+	for k, v := range examples {
+		var resp string
+		if v.opts == nil {
+			resp = Truncate(v.initialStr, v.length)
+		} else {
+			resp = Truncate(v.initialStr, v.length, *v.opts)
+		}
+
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Truncate, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
+
+func TestTruncateWords(t *testing.T) {
+	type testData struct {
+		initialStr string
+		count      int
+		opts       *TruncateOptions
+		response   string
+	}
+
+	longStr := "Once upon a time in a world far far away"
+
+	examples := map[string]testData{
+		"empty string":    testData{initialStr: "", count: 2, response: ""},
+		"short string":    testData{initialStr: "hello world", count: 2, response: "hello world"},
+		"long string":     testData{initialStr: longStr, count: 4, response: "Once upon a time..."},
+		"whitespaces":     testData{initialStr: "Once\tupon  a\ntime", count: 2, response: "Once\tupon..."},
+		"separator":       testData{initialStr: "Once<br>upon<br>a<br>time<br>in<br>a<br>world", count: 5, opts: &TruncateOptions{Separator: "<br>"}, response: "Once<br>upon<br>a<br>time<br>in..."},
+		"custom omission": testData{initialStr: "And they found that many people were sleeping better.", count: 5, opts: &TruncateOptions{Omission: utils.StringPtr("... (continued)")}, response: "And they found that many... (continued)"},
+		"zero words":      testData{initialStr: longStr, count: 0, response: "..."},
+	}
+
+	for k, v := range examples {
+		var resp string
+		if v.opts == nil {
+			resp = TruncateWords(v.initialStr, v.count)
+		} else {
+			resp = TruncateWords(v.initialStr, v.count, *v.opts)
+		}
+
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method TruncateWords, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
+
+func TestTruncateBytes(t *testing.T) {
+	type testData struct {
+		initialStr string
+		count      int
+		opts       *TruncateOptions
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty string":    testData{initialStr: "", count: 5, response: ""},
+		"short string":    testData{initialStr: "hello", count: 5, response: "hello"},
+		"ascii":           testData{initialStr: "hello world", count: 8, response: "hello..."},
+		"multibyte":       testData{initialStr: "🔪🔪🔪", count: 10, response: "🔪..."},
+		"no split":        testData{initialStr: "héllo", count: 5, response: "h..."},
+		"grapheme":        testData{initialStr: "e\u0301e\u0301e\u0301", count: 7, opts: &TruncateOptions{Omission: utils.StringPtr("")}, response: "e\u0301e\u0301"},
+		"separator":       testData{initialStr: "hello big world", count: 14, opts: &TruncateOptions{Separator: " "}, response: "hello big..."},
+		"custom omission": testData{initialStr: "hello world", count: 6, opts: &TruncateOptions{Omission: utils.StringPtr("…")}, response: "hel…"},
+	}
+
+	for k, v := range examples {
+		var resp string
+		var err error
+		if v.opts == nil {
+			resp, err = TruncateBytes(v.initialStr, v.count)
+		} else {
+			resp, err = TruncateBytes(v.initialStr, v.count, *v.opts)
+		}
+
+		if resp != v.response || err != nil {
+			t.Errorf("test [%v] failed on method TruncateBytes, expected %v got %v (error: %v)", k, v.response, resp, err)
+		}
+
+		if len(resp) > v.count {
+			t.Errorf("test [%v] failed on method TruncateBytes, expected at most %v bytes got %v", k, v.count, len(resp))
+		}
+	}
+
+	if _, err := TruncateBytes("hello world", 2); err == nil {
+		t.Errorf("test [omission too long] failed on method TruncateBytes, expected error got %v", err)
+	}
+}
@@ -0,0 +1,234 @@
+package strings
+
+import (
+	strings2 "strings"
+	"sync"
+	"unicode"
+)
+
+// ParameterizeOptions options for Parameterize
+type ParameterizeOptions struct {
+	// Separator is put between words, "-" by default
+	Separator *string
+	// PreserveCase keeps the case of letters, string is downcased by default
+	PreserveCase bool
+	// Locale selects transliteration table, active locale is used by default
+	Locale string
+}
+
+const transliterationReplacement = "?"
+
+// defaultTransliterations maps ascii approximation to the characters it replaces
+var defaultTransliterations = map[string]string{
+	// latin
+	"A": "ÀÁÂÃÄÅĀĂĄǍǺ", "a": "àáâãäåāăąǎǻª",
+	"AE": "ÆǼ", "ae": "æǽ",
+	"C": "ÇĆĈĊČ", "c": "çćĉċč",
+	"D": "ÐĎĐ", "d": "ðďđ",
+	"E": "ÈÉÊËĒĔĖĘĚ", "e": "èéêëēĕėęě",
+	"G": "ĜĞĠĢ", "g": "ĝğġģ",
+	"H": "ĤĦ", "h": "ĥħ",
+	"I": "ÌÍÎÏĨĪĬĮİǏ", "i": "ìíîïĩīĭįıǐ",
+	"IJ": "Ĳ", "ij": "ĳ",
+	"J": "Ĵ", "j": "ĵ",
+	"K": "Ķ", "k": "ķĸ",
+	"L": "ĹĻĽĿŁ", "l": "ĺļľŀł",
+	"N": "ÑŃŅŇŊ", "n": "ñńņňŉŋ",
+	"O": "ÒÓÔÕÖØŌŎŐǑǾ", "o": "òóôõöøōŏőǒǿº",
+	"OE": "Œ", "oe": "œ",
+	"R": "ŔŖŘ", "r": "ŕŗř",
+	"S": "ŚŜŞŠȘ", "s": "śŝşšșſ", "ss": "ß",
+	"T": "ŢŤŦȚ", "t": "ţťŧț",
+	"TH": "Þ", "th": "þ",
+	"U": "ÙÚÛÜŨŪŬŮŰŲǓǕǗǙǛ", "u": "ùúûüũūŭůűųǔǖǘǚǜ",
+	"W": "Ŵ", "w": "ŵ",
+	"Y": "ÝŶŸ", "y": "ýÿŷ",
+	"Z": "ŹŻŽ", "z": "źżž",
+	"f": "ƒ",
+
+	// cyrillic
+	"B": "Б", "b": "б",
+	"V": "ВΒ", "v": "вβ",
+	"Zh": "Ж", "zh": "ж",
+	"Kh": "Х", "kh": "х",
+	"Ts": "Ц", "ts": "ц",
+	"Ch": "ЧΧ", "ch": "чχ",
+	"Sh": "Ш", "sh": "ш",
+	"Shch": "Щ", "shch": "щ",
+	"Yo": "Ё", "yo": "ё",
+	"Ye": "Є", "ye": "є",
+	"Yi": "Ї", "yi": "ї",
+	"Yu": "Ю", "yu": "ю",
+	"Ya": "Я", "ya": "я",
+	"": "ЪЬъь",
+
+	// greek
+	"Th": "Θ",
+	"X":  "Ξ", "x": "ξ",
+	"Ps": "Ψ", "ps": "ψ",
+	"F": "Φ",
+}
+
+// singleLetterTransliterations contains cyrillic and greek letters which share ascii approximation with the latin ones
+var singleLetterTransliterations = map[rune]string{
+	'А': "A", 'а': "a", 'Г': "G", 'г': "g", 'Ґ': "G", 'ґ': "g", 'Д': "D", 'д': "d", 'Е': "E", 'е': "e", 'Э': "E", 'э': "e",
+	'З': "Z", 'з': "z", 'И': "I", 'и': "i", 'І': "I", 'і': "i", 'Й': "Y", 'й': "y", 'Ы': "Y", 'ы': "y", 'К': "K", 'к': "k",
+	'Л': "L", 'л': "l", 'М': "M", 'м': "m", 'Н': "N", 'н': "n", 'О': "O", 'о': "o", 'П': "P", 'п': "p", 'Р': "R", 'р': "r",
+	'С': "S", 'с': "s", 'Т': "T", 'т': "t", 'У': "U", 'у': "u", 'Ў': "U", 'ў': "u", 'Ф': "F", 'ф': "f",
+
+	'Α': "A", 'α': "a", 'Ά': "A", 'ά': "a", 'Γ': "G", 'γ': "g", 'Δ': "D", 'δ': "d", 'Ε': "E", 'ε': "e", 'Έ': "E", 'έ': "e",
+	'Ζ': "Z", 'ζ': "z", 'Η': "I", 'η': "i", 'Ή': "I", 'ή': "i", 'θ': "th", 'Ι': "I", 'ι': "i", 'Ί': "I", 'ί': "i", 'Ϊ': "I",
+	'ϊ': "i", 'ΐ': "i", 'Κ': "K", 'κ': "k", 'Λ': "L", 'λ': "l", 'Μ': "M", 'μ': "m", 'Ν': "N", 'ν': "n", 'Ο': "O", 'ο': "o",
+	'Ό': "O", 'ό': "o", 'Π': "P", 'π': "p", 'Ρ': "R", 'ρ': "r", 'Σ': "S", 'σ': "s", 'ς': "s", 'Τ': "T", 'τ': "t", 'Υ': "Y",
+	'υ': "y", 'Ύ': "Y", 'ύ': "y", 'Ϋ': "Y", 'ϋ': "y", 'ΰ': "y", 'φ': "f", 'Ω': "O", 'ω': "o", 'Ώ': "O", 'ώ': "o",
+}
+
+var (
+	transliterationsMu sync.RWMutex
+	transliterations   = map[string]map[rune]string{
+		"": buildTransliterations(),
+		"de": map[rune]string{
+			'Ä': "Ae", 'ä': "ae", 'Ö': "Oe", 'ö': "oe", 'Ü': "Ue", 'ü': "ue",
+		},
+	}
+)
+
+func buildTransliterations() map[rune]string {
+	table := make(map[rune]string)
+
+	for replacement, chars := range defaultTransliterations {
+		for _, r := range chars {
+			table[r] = replacement
+		}
+	}
+
+	for r, replacement := range singleLetterTransliterations {
+		table[r] = replacement
+	}
+
+	return table
+}
+
+// RegisterTransliterations adds transliteration rules for the given locale, rules of the locale take precedence over the default ones
+//
+// RegisterTransliterations("de", map[rune]string{'ü': "ue"})
+func RegisterTransliterations(locale string, table map[rune]string) {
+	transliterationsMu.Lock()
+	defer transliterationsMu.Unlock()
+
+	localeTable, ok := transliterations[locale]
+	if !ok {
+		localeTable = make(map[rune]string, len(table))
+		transliterations[locale] = localeTable
+	}
+
+	for r, replacement := range table {
+		localeTable[r] = replacement
+	}
+}
+
+// Transliterate replaces non-ascii characters with an ascii approximation, or if none exists, a "?" replacement character.
+// Latin, cyrillic and greek characters are supported by default, locale specific rules can be added with RegisterTransliterations
+//
+// Transliterate("Crème brûlée")    # => "Creme brulee"
+//
+// Transliterate("Jürgen", "de")    # => "Juergen"
+func Transliterate(str string, locale ...string) string {
+	transliterationsMu.RLock()
+	defer transliterationsMu.RUnlock()
+
+	var localeTable map[rune]string
+	if len(locale) > 0 {
+		localeTable = transliterations[locale[0]]
+	}
+	defaultTable := transliterations[""]
+
+	var result strings2.Builder
+	result.Grow(len(str))
+
+	for _, r := range str {
+		if r <= unicode.MaxASCII {
+			result.WriteRune(r)
+			continue
+		}
+
+		if replacement, ok := localeTable[r]; ok {
+			result.WriteString(replacement)
+			continue
+		}
+
+		if replacement, ok := defaultTable[r]; ok {
+			result.WriteString(replacement)
+			continue
+		}
+
+		// combining marks are dropped, so decomposed letters keep only the base letter
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+
+		result.WriteString(transliterationReplacement)
+	}
+
+	return result.String()
+}
+
+// Parameterize replaces special characters in a string so that it may be used as part of a 'pretty' URL
+//
+// Parameterize("Donald E. Knuth")                                       # => "donald-e-knuth"
+//
+// Parameterize("Crème brûlée")                                          # => "creme-brulee"
+//
+// Parameterize("Donald E. Knuth", ParameterizeOptions{PreserveCase: true}) # => "Donald-E-Knuth"
+func Parameterize(str string, opts ...ParameterizeOptions) string {
+	var options ParameterizeOptions
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+
+	separator := "-"
+	if options.Separator != nil {
+		separator = *options.Separator
+	}
+
+	locale := options.Locale
+	if len(locale) == 0 {
+		locale = Locale()
+	}
+
+	var result strings2.Builder
+	pendingSeparator := false
+
+	for _, r := range Transliterate(str, locale) {
+		if !isParameterRune(r) {
+			pendingSeparator = true
+			continue
+		}
+
+		if pendingSeparator && result.Len() > 0 {
+			result.WriteString(separator)
+		}
+		pendingSeparator = false
+
+		if !options.PreserveCase {
+			r = unicode.ToLower(r)
+		}
+		result.WriteRune(r)
+	}
+
+	parameterized := result.String()
+	if len(separator) == 0 {
+		return parameterized
+	}
+
+	for strings2.Contains(parameterized, separator+separator) {
+		parameterized = strings2.Replace(parameterized, separator+separator, separator, -1)
+	}
+
+	parameterized = strings2.TrimPrefix(parameterized, separator)
+	return strings2.TrimSuffix(parameterized, separator)
+}
+
+func isParameterRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' || r == '_'
+}
@@ -0,0 +1,95 @@
+package strings
+
+import (
+	"testing"
+
+	utils "github.com/maki5/rutils"
+)
+
+func TestTransliterate(t *testing.T) {
+	type testData struct {
+		initialStr string
+		locale     string
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty string":     testData{initialStr: "", response: ""},
+		"ascii string":     testData{initialStr: "hello world", response: "hello world"},
+		"latin":            testData{initialStr: "Crème brûlée", response: "Creme brulee"},
+		"latin extended":   testData{initialStr: "Łódź Straße Ærø", response: "Lodz Strasse AEro"},
+		"cyrillic":         testData{initialStr: "Щука и ёж", response: "Shchuka i yozh"},
+		"ukrainian":        testData{initialStr: "Їжак Єва", response: "Yizhak Yeva"},
+		"greek":            testData{initialStr: "Αθήνα", response: "Athina"},
+		"combining marks":  testData{initialStr: "été", response: "ete"},
+		"unknown chars":    testData{initialStr: "日本", response: "??"},
+		"locale table":     testData{initialStr: "Jürgen Müller", locale: "de", response: "Juergen Mueller"},
+		"unknown locale":   testData{initialStr: "Jürgen", locale: "xx", response: "Jurgen"},
+		"default fallback": testData{initialStr: "Straße", locale: "de", response: "Strasse"},
+	}
+
+	for k, v := range examples {
+		var resp string
+		if len(v.locale) == 0 {
+			resp = Transliterate(v.initialStr)
+		} else {
+			resp = Transliterate(v.initialStr, v.locale)
+		}
+
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Transliterate, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
+
+func TestRegisterTransliterations(t *testing.T) {
+	RegisterTransliterations("test", map[rune]string{'å': "aa", '日': "ri"})
+
+	resp := Transliterate("på 日", "test")
+	if resp != "paa ri" {
+		t.Errorf("test [registered table] failed on method Transliterate, expected %v got %v", "paa ri", resp)
+	}
+
+	resp = Transliterate("på 日")
+	if resp != "pa ?" {
+		t.Errorf("test [default table] failed on method Transliterate, expected %v got %v", "pa ?", resp)
+	}
+}
+
+func TestParameterize(t *testing.T) {
+	type testData struct {
+		initialStr string
+		opts       *ParameterizeOptions
+		response   string
+	}
+
+	examples := map[string]testData{
+		"empty string":      testData{initialStr: "", response: ""},
+		"regular string":    testData{initialStr: "Donald E. Knuth", response: "donald-e-knuth"},
+		"transliteration":   testData{initialStr: "Crème brûlée", response: "creme-brulee"},
+		"special chars":     testData{initialStr: "  Hello, World!!  ", response: "hello-world"},
+		"dashes in string":  testData{initialStr: "one - two -- three", response: "one-two-three"},
+		"underscores":       testData{initialStr: "snake_case title", response: "snake_case-title"},
+		"cyrillic":          testData{initialStr: "Привет, мир", response: "privet-mir"},
+		"custom separator":  testData{initialStr: "Donald E. Knuth", opts: &ParameterizeOptions{Separator: utils.StringPtr("_")}, response: "donald_e_knuth"},
+		"empty separator":   testData{initialStr: "Donald E. Knuth", opts: &ParameterizeOptions{Separator: utils.StringPtr("")}, response: "donaldeknuth"},
+		"preserve case":     testData{initialStr: "Donald E. Knuth", opts: &ParameterizeOptions{PreserveCase: true}, response: "Donald-E-Knuth"},
+		"locale":            testData{initialStr: "Jürgen Müller", opts: &ParameterizeOptions{Locale: "de"}, response: "juergen-mueller"},
+		"unknown chars":     testData{initialStr: "日本 guide", response: "guide"},
+		"long separator":    testData{initialStr: "a b  c", opts: &ParameterizeOptions{Separator: utils.StringPtr("--")}, response: "a--b--c"},
+		"leading separator": testData{initialStr: "-start end-", response: "start-end"},
+	}
+
+	for k, v := range examples {
+		var resp string
+		if v.opts == nil {
+			resp = Parameterize(v.initialStr)
+		} else {
+			resp = Parameterize(v.initialStr, *v.opts)
+		}
+
+		if resp != v.response {
+			t.Errorf("test [%v] failed on method Parameterize, expected %v got %v", k, v.response, resp)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package rutils
 
+import "unicode"
+
 // IntPtr returns pointer of given int
 func IntPtr(i int) *int {
 	return &i
@@ -10,20 +12,20 @@ func FloatPtr(f float64) *float64 {
 	return &f
 }
 
+// StringPtr returns pointer of given string
+func StringPtr(s string) *string {
+	return &s
+}
+
 // InverseInt inverses given int
 func InverseInt(n int) int {
 	return n - (n * 2)
 }
 
-// Blank just a copy of strings Blank created to avoid import cycle on internal usage
-// TODO: refactor it later
+// Blank checks if string is empty, in case string contains only whitespace characters (unicode.IsSpace) it will be considered empty
 func Blank(str string) bool {
-	if len(str) == 0 {
-		return true
-	}
-
 	for _, s := range str {
-		if string(s) != " " {
+		if !unicode.IsSpace(s) {
 			return false
 		}
 	}